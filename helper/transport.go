@@ -90,6 +90,20 @@ func (t *TransportWrapper) SetTLSConfig(c *tls.Config) error {
 	return nil
 }
 
+// Clone returns a new TransportWrapper around a clone of the wrapped
+// *http.Transport, so that its TLS configuration can be changed without
+// affecting the original.
+func (t *TransportWrapper) Clone() (*TransportWrapper, error) {
+	t.m.RLock()
+	defer t.m.RUnlock()
+	transport, ok := t.transport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("type assertion failed for %T", t.transport)
+	}
+
+	return NewTransport(t.name, transport.Clone(), t.options), nil
+}
+
 func (t *TransportWrapper) RoundTrip(req *http.Request) (*http.Response, error) {
 	transportID := uuid.New().String()
 	if logging.IsDebugOrHigher() {
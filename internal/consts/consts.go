@@ -40,6 +40,7 @@ const (
 	FieldNamespace                      = "namespace"
 	FieldUseRootNamespace               = "use_root_namespace"
 	FieldNamespaceID                    = "namespace_id"
+	FieldNamespaces                     = "namespaces"
 	FieldNamespacePath                  = "namespace_path"
 	FieldPathFQ                         = "path_fq"
 	FieldPathsFQ                        = "paths_fq"
@@ -76,6 +77,7 @@ const (
 	FieldAWS                            = "aws"
 	FieldPKCS                           = "pkcs"
 	FieldAzure                          = "azure"
+	FieldGCP                            = "gcp"
 	FieldLibrary                        = "library"
 	FieldKeyLabel                       = "key_label"
 	FieldKeyID                          = "key_id"
@@ -118,6 +120,7 @@ const (
 	FieldUsername                       = "username"
 	FieldPassword                       = "password"
 	FieldPasswordFile                   = "password_file"
+	FieldTOTP                           = "totp"
 	FieldClientAuth                     = "client_auth"
 	FieldAuthLoginGeneric               = "auth_login"
 	FieldAuthLoginUserpass              = "auth_login_userpass"
@@ -131,6 +134,12 @@ const (
 	FieldAuthLoginJWT                   = "auth_login_jwt"
 	FieldAuthLoginAzure                 = "auth_login_azure"
 	FieldAuthLoginTokenFile             = "auth_login_token_file"
+	FieldAuthLoginPriority              = "auth_login_priority"
+	FieldAuthLoginAppRole               = "auth_login_approle"
+	FieldAuthLoginKubernetes            = "auth_login_kubernetes"
+	FieldAuthLoginLDAP                  = "auth_login_ldap"
+	FieldAuthLoginGitHub                = "auth_login_github"
+	FieldAuthLoginOkta                  = "auth_login_okta"
 	FieldIAMHttpRequestMethod           = "iam_http_request_method"
 	FieldIAMRequestURL                  = "iam_request_url"
 	FieldIAMRequestBody                 = "iam_request_body"
@@ -155,7 +164,9 @@ const (
 	FieldSkipTLSVerify                  = "skip_tls_verify"
 	FieldTLSServerName                  = "tls_server_name"
 	FieldAddress                        = "address"
+	FieldProxyURL                       = "proxy_url"
 	FieldJWT                            = "jwt"
+	FieldJWTFile                        = "jwt_file"
 	FieldCredentials                    = "credentials"
 	FieldClientEmail                    = "client_email"
 	FieldServiceAccount                 = "service_account"
@@ -232,6 +243,8 @@ const (
 	FieldOrphan                         = "orphan"
 	FieldVaultVersionOverride           = "vault_version_override"
 	FieldSkipGetVaultVersion            = "skip_get_vault_version"
+	FieldSkipValidation                 = "skip_validation"
+	FieldCheckConnectivity              = "check_connectivity"
 	FieldMemberEntityIDs                = "member_entity_ids"
 	FieldMemberGroupIDs                 = "member_group_ids"
 	FieldExclusive                      = "exclusive"
@@ -251,6 +264,30 @@ const (
 	FieldServersJSON                    = "servers_json"
 	FieldUpgradeInfo                    = "upgrade_info"
 	FieldUpgradeInfoJSON                = "upgrade_info_json"
+	FieldHAEnabled                      = "ha_enabled"
+	FieldIsSelf                         = "is_self"
+	FieldActiveTime                     = "active_time"
+	FieldLeaderAddress                  = "leader_address"
+	FieldLeaderClusterAddress           = "leader_cluster_address"
+	FieldPerformanceStandby             = "performance_standby"
+	FieldRaftCommittedIndex             = "raft_committed_index"
+	FieldRaftAppliedIndex               = "raft_applied_index"
+	FieldNodes                          = "nodes"
+	FieldHostname                       = "hostname"
+	FieldAPIAddress                     = "api_address"
+	FieldClusterAddress                 = "cluster_address"
+	FieldActiveNode                     = "active_node"
+	FieldLastEcho                       = "last_echo"
+	FieldUpgradeVersion                 = "upgrade_version"
+	FieldRedundancyZone                 = "redundancy_zone"
+	FieldClients                        = "clients"
+	FieldEntityClients                  = "entity_clients"
+	FieldNonEntityClients               = "non_entity_clients"
+	FieldSecretSyncs                    = "secret_syncs"
+	FieldAcmeClients                    = "acme_clients"
+	FieldByNamespace                    = "by_namespace"
+	FieldByNamespaceJSON                = "by_namespace_json"
+	FieldMonthsJSON                     = "months_json"
 	FieldMaxVersions                    = "max_versions"
 	FieldCASRequired                    = "cas_required"
 	FieldDeleteVersionAfter             = "delete_version_after"
@@ -289,6 +326,7 @@ const (
 	FieldServiceAccountNames            = "service_account_names"
 	FieldDisableCheckInEnforcement      = "disable_check_in_enforcement"
 	FieldSkipChildToken                 = "skip_child_token"
+	FieldRevokeChildToken               = "revoke_child_token"
 	FieldTokenPolicies                  = "token_policies"
 	FieldManagedKeyName                 = "managed_key_name"
 	FieldManagedKeyID                   = "managed_key_id"
@@ -363,6 +401,9 @@ const (
 	FieldKeyRef                         = "key_ref"
 	FieldPemBundle                      = "pem_bundle"
 	FieldCAChain                        = "ca_chain"
+	FieldCAChainPEM                     = "ca_chain_pem"
+	FieldCRL                            = "crl"
+	FieldDeltaCRL                       = "delta_crl"
 	FieldRestrictCAChainToIssuer        = "restrict_ca_chain_to_issuer"
 	FieldCSR                            = "csr"
 	FieldUseCSRValues                   = "use_csr_values"
@@ -400,6 +441,9 @@ const (
 	FieldFilename                       = "filename"
 	FieldDefault                        = "default"
 	FieldRotationStatements             = "rotation_statements"
+	FieldRotationVersion                = "rotation_version"
+	FieldRotateRootOnCreate             = "rotate_root_on_create"
+	FieldRootRotated                    = "root_rotated"
 	FieldRotationSchedule               = "rotation_schedule"
 	FieldRotationWindow                 = "rotation_window"
 	FieldKubernetesCACert               = "kubernetes_ca_cert"
@@ -488,6 +532,8 @@ const (
 	FieldMaxRetries                     = "max_retries"
 	FieldRetryDelay                     = "retry_delay"
 	FieldMaxRetryDelay                  = "max_retry_delay"
+	FieldMinRetryWait                   = "min_retry_wait"
+	FieldMaxRetryWait                   = "max_retry_wait"
 	FieldSessionTags                    = "session_tags"
 	FieldSelfManagedPassword            = "self_managed_password"
 	FieldAllowedIssuers                 = "allowed_issuers"
@@ -544,6 +590,22 @@ const (
 	FieldDisableAutomatedRotation = "disable_automated_rotation"
 	FieldMACLength                = "mac_length"
 	FieldURLMACLength             = "url_mac_length"
+	FieldLatestVersion            = "latest_version"
+	FieldMinAvailableVersion      = "min_available_version"
+	FieldMinDecryptionVersion     = "min_decryption_version"
+	FieldMinEncryptionVersion     = "min_encryption_version"
+	FieldSupportsEncryption       = "supports_encryption"
+	FieldSupportsDecryption       = "supports_decryption"
+	FieldSupportsDerivation       = "supports_derivation"
+	FieldSupportsSigning          = "supports_signing"
+	FieldDeletionAllowed          = "deletion_allowed"
+	FieldDerived                  = "derived"
+	FieldExportable               = "exportable"
+	FieldAllowPlaintextBackup     = "allow_plaintext_backup"
+	FieldConvergentEncryption     = "convergent_encryption"
+	FieldAutoRotatePeriod         = "auto_rotate_period"
+	FieldPublicKeys               = "public_keys"
+	FieldCreationTime             = "creation_time"
 
 	FieldIntervalDuration                     = "interval_duration"
 	FieldMaintainStoredCertificateCounts      = "maintain_stored_certificate_counts"
@@ -561,6 +623,7 @@ const (
 	FieldTidyAcme                             = "tidy_acme"
 	FieldTidyRevocationQueue                  = "tidy_revocation_queue"
 	FieldSafetyBuffer                         = "safety_buffer"
+	FieldDisablePeriodicTidy                  = "disable_periodic_tidy"
 	FieldIssuerSafetyBuffer                   = "issuer_safety_buffer"
 	FieldAcmeAccountSafetyBuffer              = "acme_account_safety_buffer"
 	FieldPauseDuration                        = "pause_duration"
@@ -569,6 +632,36 @@ const (
 	FieldDeletionTime                         = "deletion_time"
 	FieldDestroyed                            = "destroyed"
 	FieldDeleteAllVersions                    = "delete_all_versions"
+	FieldDeleteBehavior                       = "delete_behavior"
+	FieldDetectDrift                          = "detect_drift"
+	FieldDataHash                             = "data_hash"
+	FieldDataBase64                           = "data_base64"
+	FieldVersions                             = "versions"
+	FieldState                                = "state"
+	FieldPrefix                               = "prefix"
+	FieldMaxDepth                             = "max_depth"
+	FieldFilter                               = "filter"
+	FieldExists                               = "exists"
+	FieldCurrentVersion                       = "current_version"
+	FieldLast                                 = "last"
+	FieldSecrets                              = "secrets"
+	FieldKVVersion                            = "kv_version"
+	FieldNameRegex                            = "name_regex"
+	FieldAutoloadingUsed                      = "autoloading_used"
+	FieldLicenseID                            = "license_id"
+	FieldExpirationTime                       = "expiration_time"
+	FieldTerminationTime                      = "termination_time"
+	FieldFeatures                             = "features"
+	FieldPerformanceStandbyCount              = "performance_standby_count"
+	FieldProject                              = "project"
+	FieldKeyRing                              = "key_ring"
+	FieldCryptoKey                            = "crypto_key"
+	FieldAllowedOrigins                       = "allowed_origins"
+	FieldAllowedHeaders                       = "allowed_headers"
+	FieldValues                               = "values"
+	FieldKeepIssuerOnDestroy                  = "keep_issuer_on_destroy"
+	FieldSigningBackend                       = "signing_backend"
+	FieldSigningIssuerRef                     = "signing_issuer_ref"
 	FieldForceNoCache                         = "force_no_cache"
 	FieldDereferenceAliases                   = "dereference_aliases"
 	FieldEnableSamaccountnameLogin            = "enable_samaccountname_login"
@@ -580,6 +673,22 @@ const (
 	FieldAudience                             = "audience"
 	FieldTokenMaxTTL                          = "token_max_ttl"
 	FieldTokenPeriod                          = "token_period"
+	FieldSubject                              = "subject"
+	FieldNotBefore                            = "not_before"
+	FieldRevocationTime                       = "revocation_time"
+	FieldRevoked                              = "revoked"
+	FieldNonce                                = "nonce"
+	FieldBits                                 = "bits"
+	FieldPlaintext                            = "plaintext"
+	FieldCiphertext                           = "ciphertext"
+	FieldKeyMaterialWO                        = "key_material_wo"
+	FieldKeyMaterialWOVersion                 = "key_material_wo_version"
+	FieldHashFunction                         = "hash_function"
+	FieldAllowRotation                        = "allow_rotation"
+	FieldSum                                  = "sum"
+	FieldSource                               = "source"
+	FieldBytes                                = "bytes"
+	FieldRandomBytes                          = "random_bytes"
 
 	/*
 		ephemeral resource constants and write-only attributes
@@ -595,6 +704,10 @@ const (
 	FieldDataJSONWOVersion    = "data_json_wo_version"
 	FieldPrivateKeyWO         = "private_key_wo"
 	FieldPrivateKeyWOVersion  = "private_key_wo_version"
+	FieldSecretKeyWO          = "secret_key_wo"
+	FieldSecretKeyWOVersion   = "secret_key_wo_version"
+	FieldAPITokenWO           = "api_token_wo"
+	FieldAPITokenWOVersion    = "api_token_wo_version"
 
 	/*
 		common environment variables
@@ -602,6 +715,14 @@ const (
 	EnvVarVaultNamespaceImport = "TERRAFORM_VAULT_NAMESPACE_IMPORT"
 	// EnvVarSkipChildToken to allow user from creating child tokens
 	EnvVarSkipChildToken = "TERRAFORM_VAULT_SKIP_CHILD_TOKEN"
+	// EnvVarRevokeChildToken to revoke the child token on provider shutdown
+	EnvVarRevokeChildToken = "TERRAFORM_VAULT_REVOKE_CHILD_TOKEN"
+	// EnvVarSetNamespaceFromToken to derive the provider namespace from the token
+	EnvVarSetNamespaceFromToken = "VAULT_SET_NAMESPACE_FROM_TOKEN"
+	// EnvVarSkipValidation to skip validation of the provider configuration
+	EnvVarSkipValidation = "TERRAFORM_VAULT_SKIP_VALIDATION"
+	// EnvVarCheckConnectivity to check the connectivity to Vault on provider configuration
+	EnvVarCheckConnectivity = "TERRAFORM_VAULT_CHECK_CONNECTIVITY"
 	// EnvVarUsername to get the username for the userpass auth method
 	EnvVarUsername = "TERRAFORM_VAULT_USERNAME"
 	// EnvVarPassword to get the password for the userpass auth method
@@ -612,8 +733,12 @@ const (
 	EnvVarGCPAuthJWT = "TERRAFORM_VAULT_GCP_AUTH_JWT"
 	// EnvVarVaultAuthJWT to login via the Vault jwt engine.
 	EnvVarVaultAuthJWT = "TERRAFORM_VAULT_AUTH_JWT"
+	// EnvVarVaultAuthJWTFile to login via the Vault jwt engine with a JWT read from a file.
+	EnvVarVaultAuthJWTFile = "TERRAFORM_VAULT_AUTH_JWT_FILE"
 	// EnvVarAzureAuthJWT to login into Vault's azure auth engine.
 	EnvVarAzureAuthJWT = "TERRAFORM_VAULT_AZURE_AUTH_JWT"
+	// EnvVarKubernetesAuthJWT to login into Vault's kubernetes auth engine.
+	EnvVarKubernetesAuthJWT = "TERRAFORM_VAULT_KUBERNETES_AUTH_JWT"
 
 	EnvVarGoogleApplicationCreds = "GOOGLE_APPLICATION_CREDENTIALS"
 
@@ -628,8 +753,29 @@ const (
 	EnvVarRadiusUsername = "RADIUS_USERNAME"
 	// EnvVarRadiusPassword for the Radius auth login
 	EnvVarRadiusPassword = "RADIUS_PASSWORD"
+	// EnvVarGitHubAuthToken for the GitHub auth login
+	EnvVarGitHubAuthToken = "VAULT_AUTH_GITHUB_TOKEN"
+	// EnvVarOktaUsername for the Okta auth login
+	EnvVarOktaUsername = "TERRAFORM_VAULT_OKTA_USERNAME"
+	// EnvVarOktaPassword for the Okta auth login
+	EnvVarOktaPassword = "TERRAFORM_VAULT_OKTA_PASSWORD"
+	// EnvVarOktaTOTP for the Okta auth login
+	EnvVarOktaTOTP = "TERRAFORM_VAULT_OKTA_TOTP"
+	// EnvVarLDAPUsername for the LDAP auth login
+	EnvVarLDAPUsername = "TERRAFORM_VAULT_LDAP_USERNAME"
+	// EnvVarLDAPPassword for the LDAP auth login
+	EnvVarLDAPPassword = "TERRAFORM_VAULT_LDAP_PASSWORD"
+	// EnvVarLDAPPasswordFile for the LDAP auth login
+	EnvVarLDAPPasswordFile = "TERRAFORM_VAULT_LDAP_PASSWORD_FILE"
+	// EnvVarAppRoleRoleID for the AppRole auth login
+	EnvVarAppRoleRoleID = "TERRAFORM_VAULT_APPROLE_ROLE_ID"
+	// EnvVarAppRoleSecretID for the AppRole auth login
+	EnvVarAppRoleSecretID = "TERRAFORM_VAULT_APPROLE_SECRET_ID"
 	// EnvVarTokenFilename for the TokenFile auth login.
 	EnvVarTokenFilename = "TERRAFORM_VAULT_TOKEN_FILENAME"
+	// EnvVarVaultTokenHelper to set the path of an external token helper,
+	// overriding the token_helper of the Vault CLI configuration.
+	EnvVarVaultTokenHelper = "VAULT_TOKEN_HELPER"
 
 	// EnvVarVaultConfigPath to override where the Vault configuration is in tests.
 	// Note: only used in tests. not used by the provider to read the Vault config.
@@ -663,6 +809,7 @@ const (
 	MountTypeSAML         = "saml"
 	MountTypeOkta         = "okta"
 	MountTypeTransit      = "transit"
+	MountTypeAppRole      = "approle"
 
 	/*
 		Vault version constants
@@ -685,16 +832,21 @@ const (
 	/*
 		Vault auth methods
 	*/
-	AuthMethodAWS      = "aws"
-	AuthMethodUserpass = "userpass"
-	AuthMethodCert     = "cert"
-	AuthMethodGCP      = "gcp"
-	AuthMethodKerberos = "kerberos"
-	AuthMethodRadius   = "radius"
-	AuthMethodOCI      = "oci"
-	AuthMethodOIDC     = "oidc"
-	AuthMethodJWT      = "jwt"
-	AuthMethodAzure    = "azure"
+	AuthMethodAWS        = "aws"
+	AuthMethodUserpass   = "userpass"
+	AuthMethodCert       = "cert"
+	AuthMethodGCP        = "gcp"
+	AuthMethodKerberos   = "kerberos"
+	AuthMethodRadius     = "radius"
+	AuthMethodOCI        = "oci"
+	AuthMethodOIDC       = "oidc"
+	AuthMethodJWT        = "jwt"
+	AuthMethodAzure      = "azure"
+	AuthMethodAppRole    = "approle"
+	AuthMethodKubernetes = "kubernetes"
+	AuthMethodLDAP       = "ldap"
+	AuthMethodGitHub     = "github"
+	AuthMethodOkta       = "okta"
 
 	/*
 		misc. path related constants
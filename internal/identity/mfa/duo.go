@@ -22,10 +22,25 @@ var duoSchemaMap = map[string]*schema.Schema{
 		Optional:    true,
 	},
 	consts.FieldSecretKey: {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "Secret key for Duo",
-		Sensitive:   true,
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Secret key for Duo",
+		Sensitive:    true,
+		ExactlyOneOf: []string{consts.FieldSecretKey, consts.FieldSecretKeyWO},
+	},
+	consts.FieldSecretKeyWO: {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Write-only secret key for Duo",
+		Sensitive:    true,
+		WriteOnly:    true,
+		ExactlyOneOf: []string{consts.FieldSecretKey, consts.FieldSecretKeyWO},
+	},
+	consts.FieldSecretKeyWOVersion: {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Description:  "Version counter for the write-only secret key",
+		RequiredWith: []string{consts.FieldSecretKeyWO},
 	},
 	consts.FieldIntegrationKey: {
 		Type:        schema.TypeString,
@@ -59,6 +74,7 @@ func GetDuoSchemaResource() (*schema.Resource, error) {
 	}, nil)
 
 	config.setAPIValueGetter(consts.FieldUsernameFormat, util.GetAPIRequestValue)
+	config.setWriteOnlyField(consts.FieldSecretKey, consts.FieldSecretKeyWO, consts.FieldSecretKeyWOVersion)
 
 	return getMethodSchemaResource(duoSchemaMap, config), nil
 }
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -166,6 +167,9 @@ type contextFuncConfig struct {
 	requireLock           bool
 	requestPath           string
 	pt                    PathType
+	// writeOnlyFields maps a field sent to Vault to the write-only field and
+	// its version field that can be used to set it instead.
+	writeOnlyFields map[string][2]string
 }
 
 // GetRequestData needed for a Vault request. Only those fields provided by
@@ -222,6 +226,13 @@ func (c *contextFuncConfig) GetWriteFields() []string {
 		computedOnly[k] = true
 	}
 
+	// the write-only fields and their version fields are never sent to Vault
+	// as is, the write-only value is sent in place of its API field.
+	for _, v := range c.writeOnlyFields {
+		computedOnly[v[0]] = true
+		computedOnly[v[1]] = true
+	}
+
 	var r []string
 	for k := range c.m {
 		if _, ok := computedOnly[k]; !ok {
@@ -242,7 +253,7 @@ func (c *contextFuncConfig) GetSecretFields() []string {
 	}
 
 	for k, s := range c.m {
-		if !s.Computed && s.Sensitive && s.Required {
+		if !s.Computed && s.Sensitive && (s.Required || c.isWriteOnlyAPIField(k)) {
 			fields = append(fields, k)
 		}
 	}
@@ -263,9 +274,30 @@ func (c *contextFuncConfig) GetRequestData(d *schema.ResourceData) map[string]in
 			result[k] = v
 		}
 	}
+
+	for k, v := range c.writeOnlyFields {
+		if p, _ := d.GetRawConfigAt(cty.GetAttrPath(v[0])); !p.IsNull() && p.IsKnown() {
+			result[k] = p.AsString()
+		}
+	}
+
 	return result
 }
 
+// setWriteOnlyField configures the write-only field woField, and its version
+// field woVersionField, to be sent to Vault as the field k.
+func (c *contextFuncConfig) setWriteOnlyField(k, woField, woVersionField string) {
+	if c.writeOnlyFields == nil {
+		c.writeOnlyFields = make(map[string][2]string)
+	}
+	c.writeOnlyFields[k] = [2]string{woField, woVersionField}
+}
+
+func (c *contextFuncConfig) isWriteOnlyAPIField(k string) bool {
+	_, ok := c.writeOnlyFields[k]
+	return ok
+}
+
 func (c *contextFuncConfig) Method() string {
 	return c.method
 }
@@ -27,10 +27,25 @@ var oktaSchemaMap = map[string]*schema.Schema{
 		Description: `Name of the organization to be used in the Okta API.`,
 	},
 	consts.FieldAPIToken: {
-		Type:        schema.TypeString,
-		Required:    true,
-		Sensitive:   true,
-		Description: `Okta API token.`,
+		Type:         schema.TypeString,
+		Optional:     true,
+		Sensitive:    true,
+		Description:  `Okta API token.`,
+		ExactlyOneOf: []string{consts.FieldAPIToken, consts.FieldAPITokenWO},
+	},
+	consts.FieldAPITokenWO: {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Sensitive:    true,
+		WriteOnly:    true,
+		Description:  `Write-only Okta API token.`,
+		ExactlyOneOf: []string{consts.FieldAPIToken, consts.FieldAPITokenWO},
+	},
+	consts.FieldAPITokenWOVersion: {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Description:  `Version counter for the write-only Okta API token.`,
+		RequiredWith: []string{consts.FieldAPITokenWO},
 	},
 	consts.FieldBaseURL: {
 		Type:        schema.TypeString,
@@ -57,6 +72,7 @@ func GetOKTASchemaResource() (*schema.Resource, error) {
 	}
 
 	config.setAPIValueGetter(consts.FieldUsernameFormat, util.GetAPIRequestValue)
+	config.setWriteOnlyField(consts.FieldAPIToken, consts.FieldAPITokenWO, consts.FieldAPITokenWOVersion)
 
 	return getMethodSchemaResource(oktaSchemaMap, config), nil
 }
@@ -7,13 +7,15 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/vault/api"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
-	"github.com/hashicorp/terraform-provider-vault/util"
 )
 
 type (
@@ -356,10 +358,16 @@ func (l *AuthLoginCommon) validate() error {
 	return nil
 }
 
+// GetAuthLogin returns the highest priority AuthLogin configured on the
+// provider, see GetAuthLoginFields.
 func GetAuthLogin(r *schema.ResourceData) (AuthLogin, error) {
-	for _, authField := range globalAuthLoginRegistry.Fields() {
-		_, ok := r.GetOk(authField)
-		if !ok {
+	authFields, err := GetAuthLoginFields(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, authField := range authFields {
+		if authField == consts.FieldToken {
 			continue
 		}
 
@@ -374,6 +382,49 @@ func GetAuthLogin(r *schema.ResourceData) (AuthLogin, error) {
 	return nil, nil
 }
 
+// GetAuthLoginFields returns the configured auth login fields in the order in
+// which they should be attempted. Multiple auth login methods can only be
+// configured along with auth_login_priority, which may also contain "token"
+// to fall back to the provider's token.
+func GetAuthLoginFields(r *schema.ResourceData) ([]string, error) {
+	var configured []string
+	for _, authField := range globalAuthLoginRegistry.Fields() {
+		if _, ok := r.GetOk(authField); ok {
+			configured = append(configured, authField)
+		}
+	}
+	sort.Strings(configured)
+
+	v, ok := r.GetOk(consts.FieldAuthLoginPriority)
+	if !ok {
+		if len(configured) > 1 {
+			return nil, fmt.Errorf("%q must be set when configuring multiple auth login methods, "+
+				"configured: %s", consts.FieldAuthLoginPriority, strings.Join(configured, ", "))
+		}
+
+		return configured, nil
+	}
+
+	var authFields []string
+	for _, f := range v.([]interface{}) {
+		authField := f.(string)
+		if authField != consts.FieldToken && !slices.Contains(configured, authField) {
+			return nil, fmt.Errorf("%q is set in %q but is not configured",
+				authField, consts.FieldAuthLoginPriority)
+		}
+		authFields = append(authFields, authField)
+	}
+
+	for _, authField := range configured {
+		if !slices.Contains(authFields, authField) {
+			return nil, fmt.Errorf("%q is configured but is not set in %q",
+				authField, consts.FieldAuthLoginPriority)
+		}
+	}
+
+	return authFields, nil
+}
+
 func mustAddLoginSchema(r *schema.Resource, authField string, defaultMount string) *schema.Resource {
 	m := map[string]*schema.Schema{
 		consts.FieldNamespace: {
@@ -423,12 +474,11 @@ func mustAddLoginSchema(r *schema.Resource, authField string, defaultMount strin
 
 func getLoginSchema(authField, description string, resourceFunc getSchemaResource) *schema.Schema {
 	return &schema.Schema{
-		Type:          schema.TypeList,
-		Optional:      true,
-		MaxItems:      1,
-		Description:   description,
-		Elem:          resourceFunc(authField),
-		ConflictsWith: util.CalculateConflictsWith(authField, globalAuthLoginRegistry.Fields()),
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem:        resourceFunc(authField),
 	}
 }
 
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+func init() {
+	field := consts.FieldAuthLoginAppRole
+	if err := globalAuthLoginRegistry.Register(field,
+		func(r *schema.ResourceData) (AuthLogin, error) {
+			a := &AuthLoginAppRole{}
+			return a.Init(r, field)
+		}, GetAppRoleLoginSchema); err != nil {
+		panic(err)
+	}
+}
+
+// GetAppRoleLoginSchema for the approle authentication engine.
+func GetAppRoleLoginSchema(authField string) *schema.Schema {
+	return getLoginSchema(
+		authField,
+		"Login to vault using the approle method",
+		GetAppRoleLoginSchemaResource,
+	)
+}
+
+// GetAppRoleLoginSchemaResource for the approle authentication engine.
+func GetAppRoleLoginSchemaResource(authField string) *schema.Resource {
+	return mustAddLoginSchema(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			consts.FieldRoleID: {
+				Type:        schema.TypeString,
+				Description: "The RoleID of the AppRole.",
+				// can be set via an env var
+				Optional: true,
+			},
+			consts.FieldSecretID: {
+				Type: schema.TypeString,
+				// can be set via an env var
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The SecretID of the AppRole, required unless bind_secret_id is disabled on the role.",
+			},
+		},
+	}, authField, consts.MountTypeAppRole)
+}
+
+var _ AuthLogin = (*AuthLoginAppRole)(nil)
+
+// AuthLoginAppRole provides an interface for authenticating to the
+// approle authentication engine.
+// Requires configuration provided by SchemaLoginAppRole.
+type AuthLoginAppRole struct {
+	AuthLoginCommon
+}
+
+// MountPath for the approle authentication engine.
+func (l *AuthLoginAppRole) MountPath() string {
+	if l.mount == "" {
+		return l.Method()
+	}
+	return l.mount
+}
+
+// LoginPath for the approle authentication engine.
+func (l *AuthLoginAppRole) LoginPath() string {
+	return fmt.Sprintf("auth/%s/login", l.MountPath())
+}
+
+func (l *AuthLoginAppRole) Init(d *schema.ResourceData, authField string) (AuthLogin, error) {
+	defaults := authDefaults{
+		{
+			field:      consts.FieldRoleID,
+			envVars:    []string{consts.EnvVarAppRoleRoleID},
+			defaultVal: "",
+		},
+		{
+			field:      consts.FieldSecretID,
+			envVars:    []string{consts.EnvVarAppRoleSecretID},
+			defaultVal: "",
+		},
+	}
+	if err := l.AuthLoginCommon.Init(d, authField,
+		func(data *schema.ResourceData, params map[string]interface{}) error {
+			return l.setDefaultFields(d, defaults, params)
+		},
+		func(data *schema.ResourceData, params map[string]interface{}) error {
+			return l.checkRequiredFields(d, params, consts.FieldRoleID)
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// Method name for the approle authentication engine.
+func (l *AuthLoginAppRole) Method() string {
+	return consts.AuthMethodAppRole
+}
+
+// Login using the approle authentication engine.
+func (l *AuthLoginAppRole) Login(client *api.Client) (*api.Secret, error) {
+	if err := l.validate(); err != nil {
+		return nil, err
+	}
+
+	params, err := l.copyParamsExcluding(
+		consts.FieldUseRootNamespace,
+		consts.FieldNamespace,
+		consts.FieldMount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// the secret_id is optional when bind_secret_id is disabled on the role
+	if v, ok := params[consts.FieldSecretID]; ok && v == "" {
+		delete(params, consts.FieldSecretID)
+	}
+
+	return l.login(client, l.LoginPath(), params)
+}
@@ -173,9 +173,9 @@ func (l *AuthLoginAzure) Login(client *api.Client) (*api.Secret, error) {
 		return nil, err
 	}
 
-	if v, ok := l.params[consts.FieldVMName]; ok {
+	if v, ok := l.params[consts.FieldVMName].(string); ok && v != "" {
 		params[consts.FieldVMName] = v
-	} else if v, ok := l.params[consts.FieldVMName]; ok {
+	} else if v, ok := l.params[consts.FieldVMSSName].(string); ok && v != "" {
 		params[consts.FieldVMSSName] = v
 	}
 
@@ -190,14 +190,16 @@ func (l *AuthLoginAzure) Login(client *api.Client) (*api.Secret, error) {
 }
 
 func (l *AuthLoginAzure) getJWT(ctx context.Context) (string, error) {
-	if v, ok := l.params[consts.FieldJWT]; ok {
-		return v.(string), nil
+	// unset fields are initialized to their zero value,
+	// so an empty JWT means that one was not provided.
+	if v, ok := l.params[consts.FieldJWT].(string); ok && v != "" {
+		return v, nil
 	}
 
 	// attempt to get the token from Azure
 	credOpts := &azidentity.ManagedIdentityCredentialOptions{}
-	if v, ok := l.params[consts.FieldClientID]; ok {
-		credOpts.ID = azidentity.ClientID(v.(string))
+	if v, ok := l.params[consts.FieldClientID].(string); ok && v != "" {
+		credOpts.ID = azidentity.ClientID(v)
 	}
 
 	creds, err := azidentity.NewManagedIdentityCredential(credOpts)
@@ -206,15 +208,15 @@ func (l *AuthLoginAzure) getJWT(ctx context.Context) (string, error) {
 	}
 
 	var scopes []string
-	if v, ok := l.params[consts.FieldScope].(string); ok {
+	if v, ok := l.params[consts.FieldScope].(string); ok && v != "" {
 		scopes = append(scopes, v)
 	}
 
 	tOpts := policy.TokenRequestOptions{
 		Scopes: scopes,
 	}
-	if v, ok := l.params[consts.FieldTenantID]; ok {
-		tOpts.TenantID = v.(string)
+	if v, ok := l.params[consts.FieldTenantID].(string); ok && v != "" {
+		tOpts.TenantID = v
 	}
 
 	token, err := creds.GetToken(ctx, tOpts)
@@ -193,15 +193,52 @@ func TestAuthLoginAzure_Login(t *testing.T) {
 			handler: &testLoginHandler{
 				handlerFunc: handlerFunc,
 			},
-			expectReqCount:     1,
-			expectReqPaths:     []string{"/v1/auth/azure/login"},
-			skipCheckReqParams: true,
+			expectReqCount: 1,
+			expectReqPaths: []string{"/v1/auth/azure/login"},
+			expectReqParams: []map[string]interface{}{
+				{
+					consts.FieldRole:              "alice",
+					consts.FieldJWT:               "jwt1",
+					consts.FieldSubscriptionID:    "sub1",
+					consts.FieldResourceGroupName: "res1",
+					consts.FieldVMSSName:          "vmss1",
+				},
+			},
+			want: &api.Secret{
+				Data: map[string]interface{}{
+					"auth_login": "azure",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "auth-with-jwt-vm-name",
+			authLogin: &AuthLoginAzure{
+				AuthLoginCommon: AuthLoginCommon{
+					authField: consts.FieldAuthLoginAzure,
+					params: map[string]interface{}{
+						consts.FieldRole:              "alice",
+						consts.FieldJWT:               "jwt1",
+						consts.FieldSubscriptionID:    "sub1",
+						consts.FieldResourceGroupName: "res1",
+						consts.FieldVMName:            "vm1",
+						consts.FieldVMSSName:          "",
+					},
+					initialized: true,
+				},
+			},
+			handler: &testLoginHandler{
+				handlerFunc: handlerFunc,
+			},
+			expectReqCount: 1,
+			expectReqPaths: []string{"/v1/auth/azure/login"},
 			expectReqParams: []map[string]interface{}{
 				{
 					consts.FieldRole:              "alice",
 					consts.FieldJWT:               "jwt1",
 					consts.FieldSubscriptionID:    "sub1",
 					consts.FieldResourceGroupName: "res1",
+					consts.FieldVMName:            "vm1",
 				},
 			},
 			want: &api.Secret{
@@ -113,11 +113,6 @@ func (l *AuthLoginCert) Login(client *api.Client) (*api.Secret, error) {
 		return nil, err
 	}
 
-	c, err := client.Clone()
-	if err != nil {
-		return nil, err
-	}
-
 	config := client.CloneConfig()
 	tlsConfig := config.TLSConfig()
 	if tlsConfig == nil {
@@ -147,17 +142,36 @@ func (l *AuthLoginCert) Login(client *api.Client) (*api.Secret, error) {
 		return &clientCert, nil
 	}
 
+	// the transport is shared with the original client, so it must be cloned
+	// in order to avoid leaking the client certificate into subsequent requests.
 	switch t := config.HttpClient.Transport.(type) {
 	case *helper.TransportWrapper:
-		if err := t.SetTLSConfig(tlsConfig); err != nil {
+		transport, err := t.Clone()
+		if err != nil {
+			return nil, err
+		}
+		if err := transport.SetTLSConfig(tlsConfig); err != nil {
 			return nil, err
 		}
+		config.HttpClient.Transport = transport
 	case *http.Transport:
-		t.TLSClientConfig = tlsConfig
+		transport := t.Clone()
+		transport.TLSClientConfig = tlsConfig
+		config.HttpClient.Transport = transport
 	default:
 		return nil, fmt.Errorf("HTTPClient has unsupported Transport type %T", t)
 	}
 
+	c, err := api.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// NewClient may set the token and headers from the environment,
+	// so ensure they match those of the original client.
+	c.SetToken(client.Token())
+	c.SetHeaders(client.Headers())
+
 	params := make(map[string]interface{})
 	if v, ok := l.params[consts.FieldName]; ok {
 		// the cert auth API only supports the role name parameter
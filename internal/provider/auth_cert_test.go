@@ -363,3 +363,66 @@ func TestAuthLoginCert_Login(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthLoginCert_Login_transportIsolation(t *testing.T) {
+	t.Setenv("VAULT_TOKEN", "")
+
+	tempDir := t.TempDir()
+	b, k, err := testutil.GenerateCA()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile := path.Join(tempDir, "cert.crt")
+	if err := os.WriteFile(certFile, b, 0o400); err != nil {
+		t.Fatal(err)
+	}
+
+	keyFile := path.Join(tempDir, "cert.key")
+	if err := os.WriteFile(keyFile, k, 0o400); err != nil {
+		t.Fatal(err)
+	}
+
+	config, ln := testutil.TestHTTPSServer(t, http.HandlerFunc(
+		func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"auth": {"client_token": "foo"}}`))
+		},
+	))
+	defer ln.Close()
+
+	c, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.ClearToken()
+
+	transport, ok := c.CloneConfig().HttpClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("unexpected transport type %T", c.CloneConfig().HttpClient.Transport)
+	}
+
+	l := &AuthLoginCert{
+		AuthLoginCommon{
+			authField: consts.FieldAuthLoginCert,
+			params: map[string]interface{}{
+				consts.FieldCertFile:      certFile,
+				consts.FieldKeyFile:       keyFile,
+				consts.FieldSkipTLSVerify: true,
+			},
+			initialized: true,
+		},
+	}
+
+	if _, err := l.Login(c); err != nil {
+		t.Fatal(err)
+	}
+
+	if transport.TLSClientConfig.GetClientCertificate != nil {
+		t.Errorf("Login() set the client certificate on the original client's transport")
+	}
+
+	if transport.TLSClientConfig.InsecureSkipVerify {
+		t.Errorf("Login() set InsecureSkipVerify on the original client's transport")
+	}
+}
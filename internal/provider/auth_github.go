@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+func init() {
+	field := consts.FieldAuthLoginGitHub
+	if err := globalAuthLoginRegistry.Register(field,
+		func(r *schema.ResourceData) (AuthLogin, error) {
+			a := &AuthLoginGitHub{}
+			return a.Init(r, field)
+		}, GetGitHubLoginSchema); err != nil {
+		panic(err)
+	}
+}
+
+// GetGitHubLoginSchema for the github authentication engine.
+func GetGitHubLoginSchema(authField string) *schema.Schema {
+	return getLoginSchema(
+		authField,
+		"Login to vault using the github method",
+		GetGitHubLoginSchemaResource,
+	)
+}
+
+// GetGitHubLoginSchemaResource for the github authentication engine.
+func GetGitHubLoginSchemaResource(authField string) *schema.Resource {
+	return mustAddLoginSchema(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			consts.FieldToken: {
+				Type: schema.TypeString,
+				// can be set via an env var
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The GitHub personal access token.",
+			},
+		},
+	}, authField, consts.MountTypeGitHub)
+}
+
+var _ AuthLogin = (*AuthLoginGitHub)(nil)
+
+// AuthLoginGitHub provides an interface for authenticating to the
+// github authentication engine.
+// Requires configuration provided by SchemaLoginGitHub.
+type AuthLoginGitHub struct {
+	AuthLoginCommon
+}
+
+// MountPath for the github authentication engine.
+func (l *AuthLoginGitHub) MountPath() string {
+	if l.mount == "" {
+		return l.Method()
+	}
+	return l.mount
+}
+
+// LoginPath for the github authentication engine.
+func (l *AuthLoginGitHub) LoginPath() string {
+	return fmt.Sprintf("auth/%s/login", l.MountPath())
+}
+
+func (l *AuthLoginGitHub) Init(d *schema.ResourceData, authField string) (AuthLogin, error) {
+	defaults := authDefaults{
+		{
+			field:      consts.FieldToken,
+			envVars:    []string{consts.EnvVarGitHubAuthToken},
+			defaultVal: "",
+		},
+	}
+	if err := l.AuthLoginCommon.Init(d, authField,
+		func(data *schema.ResourceData, params map[string]interface{}) error {
+			return l.setDefaultFields(d, defaults, params)
+		},
+		func(data *schema.ResourceData, params map[string]interface{}) error {
+			return l.checkRequiredFields(d, params, consts.FieldToken)
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// Method name for the github authentication engine.
+func (l *AuthLoginGitHub) Method() string {
+	return consts.AuthMethodGitHub
+}
+
+// Login using the github authentication engine.
+func (l *AuthLoginGitHub) Login(client *api.Client) (*api.Secret, error) {
+	if err := l.validate(); err != nil {
+		return nil, err
+	}
+
+	params, err := l.copyParamsExcluding(
+		consts.FieldUseRootNamespace,
+		consts.FieldNamespace,
+		consts.FieldMount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.login(client, l.LoginPath(), params)
+}
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+func TestAuthLoginGitHub_Init(t *testing.T) {
+	tests := []authLoginInitTest{
+		{
+			name:      "basic",
+			authField: consts.FieldAuthLoginGitHub,
+			raw: map[string]interface{}{
+				consts.FieldAuthLoginGitHub: []interface{}{
+					map[string]interface{}{
+						consts.FieldNamespace: "ns1",
+						consts.FieldToken:     "token-1",
+					},
+				},
+			},
+			expectParams: map[string]interface{}{
+				consts.FieldNamespace:        "ns1",
+				consts.FieldUseRootNamespace: false,
+				consts.FieldMount:            consts.MountTypeGitHub,
+				consts.FieldToken:            "token-1",
+			},
+			wantErr: false,
+		},
+		{
+			name:      "basic-with-env",
+			authField: consts.FieldAuthLoginGitHub,
+			raw: map[string]interface{}{
+				consts.FieldAuthLoginGitHub: []interface{}{
+					map[string]interface{}{
+						consts.FieldNamespace: "ns1",
+					},
+				},
+			},
+			envVars: map[string]string{
+				consts.EnvVarGitHubAuthToken: "token-1",
+			},
+			expectParams: map[string]interface{}{
+				consts.FieldNamespace:        "ns1",
+				consts.FieldUseRootNamespace: false,
+				consts.FieldMount:            consts.MountTypeGitHub,
+				consts.FieldToken:            "token-1",
+			},
+			wantErr: false,
+		},
+		{
+			name:         "error-missing-resource",
+			authField:    consts.FieldAuthLoginGitHub,
+			expectParams: nil,
+			wantErr:      true,
+			expectErr:    fmt.Errorf("resource data missing field %q", consts.FieldAuthLoginGitHub),
+		},
+		{
+			name:      "error-missing-required",
+			authField: consts.FieldAuthLoginGitHub,
+			raw: map[string]interface{}{
+				consts.FieldAuthLoginGitHub: []interface{}{
+					map[string]interface{}{
+						consts.FieldMount: "github-1",
+					},
+				},
+			},
+			expectParams: nil,
+			wantErr:      true,
+			expectErr: fmt.Errorf("required fields are unset: %v", []string{
+				consts.FieldToken,
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := map[string]*schema.Schema{
+				tt.authField: GetGitHubLoginSchema(tt.authField),
+			}
+			assertAuthLoginInit(t, tt, s, &AuthLoginGitHub{})
+		})
+	}
+}
+
+func TestAuthLoginGitHub_LoginPath(t *testing.T) {
+	type fields struct {
+		AuthLoginCommon AuthLoginCommon
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   string
+	}{
+		{
+			name: "default",
+			fields: fields{
+				AuthLoginCommon: AuthLoginCommon{
+					params: map[string]interface{}{
+						consts.FieldToken: "token-1",
+					},
+				},
+			},
+			want: "auth/github/login",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &AuthLoginGitHub{
+				AuthLoginCommon: tt.fields.AuthLoginCommon,
+			}
+			if got := l.LoginPath(); got != tt.want {
+				t.Errorf("LoginPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthLoginGitHub_Login(t *testing.T) {
+	handlerFunc := func(t *testLoginHandler, w http.ResponseWriter, req *http.Request) {
+		m, err := json.Marshal(
+			&api.Secret{
+				Data: map[string]interface{}{
+					"auth_login": "github",
+				},
+			},
+		)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(m); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	tests := []authLoginTest{
+		{
+			name: "basic",
+			authLogin: &AuthLoginGitHub{
+				AuthLoginCommon: AuthLoginCommon{
+					authField: consts.FieldAuthLoginGitHub,
+					params: map[string]interface{}{
+						consts.FieldToken: "token-1",
+					},
+					initialized: true,
+				},
+			},
+			handler: &testLoginHandler{
+				handlerFunc: handlerFunc,
+			},
+			expectReqCount: 1,
+			expectReqPaths: []string{"/v1/auth/github/login"},
+			expectReqParams: []map[string]interface{}{
+				{
+					consts.FieldToken: "token-1",
+				},
+			},
+			want: &api.Secret{
+				Data: map[string]interface{}{
+					"auth_login": "github",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "error-vault-token-set",
+			authLogin: &AuthLoginGitHub{
+				AuthLoginCommon: AuthLoginCommon{
+					authField: consts.FieldAuthLoginGitHub,
+					params: map[string]interface{}{
+						consts.FieldToken: "token-1",
+					},
+					initialized: true,
+				},
+			},
+			handler: &testLoginHandler{
+				handlerFunc: handlerFunc,
+			},
+			token:     "foo",
+			wantErr:   true,
+			expectErr: errors.New("vault login client has a token set"),
+		},
+		{
+			name: "error-uninitialized",
+			authLogin: &AuthLoginGitHub{
+				AuthLoginCommon: AuthLoginCommon{
+					initialized: false,
+				},
+			},
+			handler: &testLoginHandler{
+				handlerFunc: handlerFunc,
+			},
+			expectReqCount: 0,
+			want:           nil,
+			wantErr:        true,
+			expectErr:      authLoginInitCheckError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testAuthLogin(t, tt)
+		})
+	}
+}
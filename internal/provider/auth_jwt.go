@@ -5,6 +5,8 @@ package provider
 
 import (
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/vault/api"
@@ -47,6 +49,15 @@ func GetJWTLoginSchemaResource(authField string) *schema.Resource {
 				Optional:    true,
 				Description: "A signed JSON Web Token.",
 			},
+			consts.FieldJWTFile: {
+				Type: schema.TypeString,
+				// can be set via an env var
+				Optional:    true,
+				Description: "Path to a file containing a signed JSON Web Token.",
+				ConflictsWith: []string{
+					fmt.Sprintf("%s.0.%s", authField, consts.FieldJWT),
+				},
+			},
 		},
 	}, authField, consts.MountTypeJWT)
 }
@@ -77,6 +88,11 @@ func (l *AuthLoginJWT) Init(d *schema.ResourceData, authField string) (AuthLogin
 			envVars:    []string{consts.EnvVarVaultAuthJWT},
 			defaultVal: "",
 		},
+		{
+			field:      consts.FieldJWTFile,
+			envVars:    []string{consts.EnvVarVaultAuthJWTFile},
+			defaultVal: "",
+		},
 	}
 
 	if err := l.AuthLoginCommon.Init(d, authField,
@@ -84,7 +100,12 @@ func (l *AuthLoginJWT) Init(d *schema.ResourceData, authField string) (AuthLogin
 			return l.setDefaultFields(d, defaults, params)
 		},
 		func(data *schema.ResourceData, params map[string]interface{}) error {
-			return l.checkRequiredFields(d, params, consts.FieldRole, consts.FieldJWT)
+			required := []string{consts.FieldRole}
+			// the JWT can be read from a file instead.
+			if v, ok := params[consts.FieldJWTFile]; !ok || v == "" {
+				required = append(required, consts.FieldJWT)
+			}
+			return l.checkRequiredFields(d, params, required...)
 		},
 	); err != nil {
 		return nil, err
@@ -108,10 +129,37 @@ func (l *AuthLoginJWT) Login(client *api.Client) (*api.Secret, error) {
 		consts.FieldUseRootNamespace,
 		consts.FieldNamespace,
 		consts.FieldMount,
+		consts.FieldJWTFile,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	if v, ok := params[consts.FieldJWT]; !ok || v == "" {
+		if filename, ok := l.params[consts.FieldJWTFile].(string); ok && filename != "" {
+			jwt, err := readJWTFile(filename)
+			if err != nil {
+				return nil, err
+			}
+			params[consts.FieldJWT] = jwt
+		}
+	}
+
 	return l.login(client, l.LoginPath(), params)
 }
+
+// readJWTFile returns the JWT contained in filename,
+// with any surrounding whitespace removed.
+func readJWTFile(filename string) (string, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return "", fmt.Errorf("failed to read JWT file: %w", err)
+	}
+
+	jwt := strings.TrimSpace(string(b))
+	if jwt == "" {
+		return "", fmt.Errorf("no JWT found in %q", filename)
+	}
+
+	return jwt, nil
+}
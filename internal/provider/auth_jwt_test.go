@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -36,6 +38,7 @@ func TestAuthLoginJWT_Init(t *testing.T) {
 				consts.FieldMount:            consts.MountTypeJWT,
 				consts.FieldRole:             "alice",
 				consts.FieldJWT:              "jwt1",
+				consts.FieldJWTFile:          "",
 			},
 			wantErr: false,
 		},
@@ -59,6 +62,30 @@ func TestAuthLoginJWT_Init(t *testing.T) {
 				consts.FieldMount:            consts.MountTypeJWT,
 				consts.FieldRole:             "alice",
 				consts.FieldJWT:              "jwt1",
+				consts.FieldJWTFile:          "",
+			},
+			wantErr: false,
+		},
+		{
+			name:      "with-jwt-file-env",
+			authField: consts.FieldAuthLoginJWT,
+			raw: map[string]interface{}{
+				consts.FieldAuthLoginJWT: []interface{}{
+					map[string]interface{}{
+						consts.FieldRole: "alice",
+					},
+				},
+			},
+			envVars: map[string]string{
+				consts.EnvVarVaultAuthJWTFile: "/tmp/jwt",
+			},
+			expectParams: map[string]interface{}{
+				consts.FieldNamespace:        "",
+				consts.FieldUseRootNamespace: false,
+				consts.FieldMount:            consts.MountTypeJWT,
+				consts.FieldRole:             "alice",
+				consts.FieldJWT:              "",
+				consts.FieldJWTFile:          "/tmp/jwt",
 			},
 			wantErr: false,
 		},
@@ -151,6 +178,11 @@ func TestAuthLoginJWT_Login(t *testing.T) {
 		}
 	}
 
+	jwtFile := filepath.Join(t.TempDir(), "jwt")
+	if err := os.WriteFile(jwtFile, []byte("jwt2\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
 	tests := []authLoginTest{
 		{
 			name: "basic",
@@ -182,6 +214,37 @@ func TestAuthLoginJWT_Login(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "jwt-file",
+			authLogin: &AuthLoginJWT{
+				AuthLoginCommon: AuthLoginCommon{
+					authField: consts.FieldAuthLoginJWT,
+					params: map[string]interface{}{
+						consts.FieldRole:    "alice",
+						consts.FieldJWT:     "",
+						consts.FieldJWTFile: jwtFile,
+					},
+					initialized: true,
+				},
+			},
+			handler: &testLoginHandler{
+				handlerFunc: handlerFunc,
+			},
+			expectReqCount: 1,
+			expectReqPaths: []string{"/v1/auth/jwt/login"},
+			expectReqParams: []map[string]interface{}{
+				{
+					consts.FieldRole: "alice",
+					consts.FieldJWT:  "jwt2",
+				},
+			},
+			want: &api.Secret{
+				Data: map[string]interface{}{
+					"auth_login": "jwt",
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "error-vault-token-set",
 			authLogin: &AuthLoginJWT{
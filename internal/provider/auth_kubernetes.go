@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+// defaultKubernetesJWTFile is where Kubernetes mounts the pod's service
+// account token.
+const defaultKubernetesJWTFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+func init() {
+	field := consts.FieldAuthLoginKubernetes
+	if err := globalAuthLoginRegistry.Register(field,
+		func(r *schema.ResourceData) (AuthLogin, error) {
+			a := &AuthLoginKubernetes{}
+			return a.Init(r, field)
+		}, GetKubernetesLoginSchema); err != nil {
+		panic(err)
+	}
+}
+
+// GetKubernetesLoginSchema for the kubernetes authentication engine.
+func GetKubernetesLoginSchema(authField string) *schema.Schema {
+	return getLoginSchema(
+		authField,
+		"Login to vault using the kubernetes method",
+		GetKubernetesLoginSchemaResource,
+	)
+}
+
+// GetKubernetesLoginSchemaResource for the kubernetes authentication engine.
+func GetKubernetesLoginSchemaResource(authField string) *schema.Resource {
+	return mustAddLoginSchema(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			consts.FieldRole: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the login role.",
+			},
+			consts.FieldJWT: {
+				Type: schema.TypeString,
+				// can be set via an env var
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The service account JWT, takes precedence over jwt_file.",
+			},
+			consts.FieldJWTFile: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path to a file containing the service account JWT.",
+			},
+		},
+	}, authField, consts.MountTypeKubernetes)
+}
+
+var _ AuthLogin = (*AuthLoginKubernetes)(nil)
+
+// AuthLoginKubernetes provides an interface for authenticating to the
+// kubernetes authentication engine.
+// Requires configuration provided by SchemaLoginKubernetes.
+type AuthLoginKubernetes struct {
+	AuthLoginCommon
+}
+
+// MountPath for the kubernetes authentication engine.
+func (l *AuthLoginKubernetes) MountPath() string {
+	if l.mount == "" {
+		return l.Method()
+	}
+	return l.mount
+}
+
+// LoginPath for the kubernetes authentication engine.
+func (l *AuthLoginKubernetes) LoginPath() string {
+	return fmt.Sprintf("auth/%s/login", l.MountPath())
+}
+
+func (l *AuthLoginKubernetes) Init(d *schema.ResourceData, authField string) (AuthLogin, error) {
+	defaults := authDefaults{
+		{
+			field:      consts.FieldJWT,
+			envVars:    []string{consts.EnvVarKubernetesAuthJWT},
+			defaultVal: "",
+		},
+		{
+			field:      consts.FieldJWTFile,
+			defaultVal: defaultKubernetesJWTFile,
+		},
+	}
+
+	if err := l.AuthLoginCommon.Init(d, authField,
+		func(data *schema.ResourceData, params map[string]interface{}) error {
+			return l.setDefaultFields(d, defaults, params)
+		},
+		func(data *schema.ResourceData, params map[string]interface{}) error {
+			return l.checkRequiredFields(d, params, consts.FieldRole)
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// Method name for the kubernetes authentication engine.
+func (l *AuthLoginKubernetes) Method() string {
+	return consts.AuthMethodKubernetes
+}
+
+// Login using the kubernetes authentication engine.
+func (l *AuthLoginKubernetes) Login(client *api.Client) (*api.Secret, error) {
+	if err := l.validate(); err != nil {
+		return nil, err
+	}
+
+	params, err := l.copyParamsExcluding(
+		consts.FieldUseRootNamespace,
+		consts.FieldNamespace,
+		consts.FieldMount,
+		consts.FieldJWTFile,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := params[consts.FieldJWT]; !ok || v == "" {
+		filename, _ := l.params[consts.FieldJWTFile].(string)
+		if filename == "" {
+			filename = defaultKubernetesJWTFile
+		}
+
+		jwt, err := readJWTFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		params[consts.FieldJWT] = jwt
+	}
+
+	return l.login(client, l.LoginPath(), params)
+}
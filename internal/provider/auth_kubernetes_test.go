@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+func TestAuthLoginKubernetes_Init(t *testing.T) {
+	tests := []authLoginInitTest{
+		{
+			name:      "basic",
+			authField: consts.FieldAuthLoginKubernetes,
+			raw: map[string]interface{}{
+				consts.FieldAuthLoginKubernetes: []interface{}{
+					map[string]interface{}{
+						consts.FieldNamespace: "ns1",
+						consts.FieldRole:      "alice",
+						consts.FieldJWT:       "jwt1",
+					},
+				},
+			},
+			expectParams: map[string]interface{}{
+				consts.FieldNamespace:        "ns1",
+				consts.FieldUseRootNamespace: false,
+				consts.FieldMount:            consts.MountTypeKubernetes,
+				consts.FieldRole:             "alice",
+				consts.FieldJWT:              "jwt1",
+				consts.FieldJWTFile:          defaultKubernetesJWTFile,
+			},
+			wantErr: false,
+		},
+		{
+			name:      "basic-with-env",
+			authField: consts.FieldAuthLoginKubernetes,
+			raw: map[string]interface{}{
+				consts.FieldAuthLoginKubernetes: []interface{}{
+					map[string]interface{}{
+						consts.FieldNamespace: "ns1",
+						consts.FieldRole:      "alice",
+					},
+				},
+			},
+			envVars: map[string]string{
+				consts.EnvVarKubernetesAuthJWT: "jwt1",
+			},
+			expectParams: map[string]interface{}{
+				consts.FieldNamespace:        "ns1",
+				consts.FieldUseRootNamespace: false,
+				consts.FieldMount:            consts.MountTypeKubernetes,
+				consts.FieldRole:             "alice",
+				consts.FieldJWT:              "jwt1",
+				consts.FieldJWTFile:          defaultKubernetesJWTFile,
+			},
+			wantErr: false,
+		},
+		{
+			name:      "with-jwt-file",
+			authField: consts.FieldAuthLoginKubernetes,
+			raw: map[string]interface{}{
+				consts.FieldAuthLoginKubernetes: []interface{}{
+					map[string]interface{}{
+						consts.FieldMount:   "k8s",
+						consts.FieldRole:    "alice",
+						consts.FieldJWTFile: "/tmp/token",
+					},
+				},
+			},
+			expectParams: map[string]interface{}{
+				consts.FieldNamespace:        "",
+				consts.FieldUseRootNamespace: false,
+				consts.FieldMount:            "k8s",
+				consts.FieldRole:             "alice",
+				consts.FieldJWT:              "",
+				consts.FieldJWTFile:          "/tmp/token",
+			},
+			wantErr: false,
+		},
+		{
+			name:         "error-missing-resource",
+			authField:    consts.FieldAuthLoginKubernetes,
+			expectParams: nil,
+			wantErr:      true,
+			expectErr:    fmt.Errorf("resource data missing field %q", consts.FieldAuthLoginKubernetes),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := map[string]*schema.Schema{
+				tt.authField: GetKubernetesLoginSchema(tt.authField),
+			}
+			assertAuthLoginInit(t, tt, s, &AuthLoginKubernetes{})
+		})
+	}
+}
+
+func TestAuthLoginKubernetes_LoginPath(t *testing.T) {
+	type fields struct {
+		AuthLoginCommon AuthLoginCommon
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   string
+	}{
+		{
+			name: "default",
+			fields: fields{
+				AuthLoginCommon: AuthLoginCommon{
+					params: map[string]interface{}{
+						consts.FieldRole: "alice",
+						consts.FieldJWT:  "jwt1",
+					},
+				},
+			},
+			want: "auth/kubernetes/login",
+		},
+		{
+			name: "other",
+			fields: fields{
+				AuthLoginCommon: AuthLoginCommon{
+					mount: "k8s",
+					params: map[string]interface{}{
+						consts.FieldRole: "alice",
+						consts.FieldJWT:  "jwt1",
+					},
+				},
+			},
+			want: "auth/k8s/login",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &AuthLoginKubernetes{
+				AuthLoginCommon: tt.fields.AuthLoginCommon,
+			}
+			if got := l.LoginPath(); got != tt.want {
+				t.Errorf("LoginPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthLoginKubernetes_Login(t *testing.T) {
+	handlerFunc := func(t *testLoginHandler, w http.ResponseWriter, req *http.Request) {
+		m, err := json.Marshal(
+			&api.Secret{
+				Data: map[string]interface{}{
+					"auth_login": "kubernetes",
+				},
+			},
+		)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(m); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	tempDir := t.TempDir()
+	jwtFile := filepath.Join(tempDir, "token")
+	if err := os.WriteFile(jwtFile, []byte("jwt2\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []authLoginTest{
+		{
+			name: "basic",
+			authLogin: &AuthLoginKubernetes{
+				AuthLoginCommon: AuthLoginCommon{
+					authField: consts.FieldAuthLoginKubernetes,
+					params: map[string]interface{}{
+						consts.FieldRole:    "alice",
+						consts.FieldJWT:     "jwt1",
+						consts.FieldJWTFile: defaultKubernetesJWTFile,
+					},
+					initialized: true,
+				},
+			},
+			handler: &testLoginHandler{
+				handlerFunc: handlerFunc,
+			},
+			expectReqCount: 1,
+			expectReqPaths: []string{"/v1/auth/kubernetes/login"},
+			expectReqParams: []map[string]interface{}{
+				{
+					consts.FieldRole: "alice",
+					consts.FieldJWT:  "jwt1",
+				},
+			},
+			want: &api.Secret{
+				Data: map[string]interface{}{
+					"auth_login": "kubernetes",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "jwt-file",
+			authLogin: &AuthLoginKubernetes{
+				AuthLoginCommon: AuthLoginCommon{
+					authField: consts.FieldAuthLoginKubernetes,
+					mount:     "k8s",
+					params: map[string]interface{}{
+						consts.FieldRole:    "alice",
+						consts.FieldJWT:     "",
+						consts.FieldJWTFile: jwtFile,
+					},
+					initialized: true,
+				},
+			},
+			handler: &testLoginHandler{
+				handlerFunc: handlerFunc,
+			},
+			expectReqCount: 1,
+			expectReqPaths: []string{"/v1/auth/k8s/login"},
+			expectReqParams: []map[string]interface{}{
+				{
+					consts.FieldRole: "alice",
+					consts.FieldJWT:  "jwt2",
+				},
+			},
+			want: &api.Secret{
+				Data: map[string]interface{}{
+					"auth_login": "kubernetes",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "error-jwt-file-missing",
+			authLogin: &AuthLoginKubernetes{
+				AuthLoginCommon: AuthLoginCommon{
+					authField: consts.FieldAuthLoginKubernetes,
+					params: map[string]interface{}{
+						consts.FieldRole:    "alice",
+						consts.FieldJWT:     "",
+						consts.FieldJWTFile: filepath.Join(tempDir, "missing"),
+					},
+					initialized: true,
+				},
+			},
+			handler: &testLoginHandler{
+				handlerFunc: handlerFunc,
+			},
+			expectReqCount: 0,
+			want:           nil,
+			wantErr:        true,
+		},
+		{
+			name: "error-vault-token-set",
+			authLogin: &AuthLoginKubernetes{
+				AuthLoginCommon: AuthLoginCommon{
+					authField: consts.FieldAuthLoginKubernetes,
+					params: map[string]interface{}{
+						consts.FieldRole: "alice",
+						consts.FieldJWT:  "jwt1",
+					},
+					initialized: true,
+				},
+			},
+			handler: &testLoginHandler{
+				handlerFunc: handlerFunc,
+			},
+			token:     "foo",
+			wantErr:   true,
+			expectErr: errors.New("vault login client has a token set"),
+		},
+		{
+			name: "error-uninitialized",
+			authLogin: &AuthLoginKubernetes{
+				AuthLoginCommon: AuthLoginCommon{
+					initialized: false,
+				},
+			},
+			handler: &testLoginHandler{
+				handlerFunc: handlerFunc,
+			},
+			expectReqCount: 0,
+			want:           nil,
+			wantErr:        true,
+			expectErr:      authLoginInitCheckError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testAuthLogin(t, tt)
+		})
+	}
+}
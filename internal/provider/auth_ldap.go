@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+func init() {
+	field := consts.FieldAuthLoginLDAP
+	if err := globalAuthLoginRegistry.Register(field,
+		func(r *schema.ResourceData) (AuthLogin, error) {
+			a := &AuthLoginLDAP{}
+			return a.Init(r, field)
+		}, GetLDAPLoginSchema); err != nil {
+		panic(err)
+	}
+}
+
+// GetLDAPLoginSchema for the ldap authentication engine.
+func GetLDAPLoginSchema(authField string) *schema.Schema {
+	return getLoginSchema(
+		authField,
+		"Login to vault using the ldap method",
+		GetLDAPLoginSchemaResource,
+	)
+}
+
+// GetLDAPLoginSchemaResource for the ldap authentication engine.
+func GetLDAPLoginSchemaResource(authField string) *schema.Resource {
+	return mustAddLoginSchema(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			consts.FieldUsername: {
+				Type: schema.TypeString,
+				// can be set via an env var
+				Optional:    true,
+				Description: "The LDAP username.",
+			},
+			consts.FieldPassword: {
+				Type: schema.TypeString,
+				// can be set via an env var
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The LDAP password.",
+			},
+			consts.FieldPasswordFile: {
+				Type: schema.TypeString,
+				// can be set via an env var
+				Optional:    true,
+				Description: "Path to a file containing the LDAP password.",
+				ConflictsWith: []string{
+					fmt.Sprintf("%s.0.%s", authField, consts.FieldPassword),
+				},
+			},
+		},
+	}, authField, consts.MountTypeLDAP)
+}
+
+var _ AuthLogin = (*AuthLoginLDAP)(nil)
+
+// AuthLoginLDAP provides an interface for authenticating to the
+// ldap authentication engine.
+// Requires configuration provided by SchemaLoginLDAP.
+type AuthLoginLDAP struct {
+	AuthLoginCommon
+}
+
+// MountPath for the ldap authentication engine.
+func (l *AuthLoginLDAP) MountPath() string {
+	if l.mount == "" {
+		return l.Method()
+	}
+	return l.mount
+}
+
+// LoginPath for the ldap authentication engine.
+func (l *AuthLoginLDAP) LoginPath() string {
+	return fmt.Sprintf("auth/%s/login/%s", l.MountPath(), l.params[consts.FieldUsername])
+}
+
+func (l *AuthLoginLDAP) Init(d *schema.ResourceData, authField string) (AuthLogin, error) {
+	defaults := authDefaults{
+		{
+			field:      consts.FieldUsername,
+			envVars:    []string{consts.EnvVarLDAPUsername},
+			defaultVal: "",
+		},
+		{
+			field:      consts.FieldPassword,
+			envVars:    []string{consts.EnvVarLDAPPassword},
+			defaultVal: "",
+		},
+		{
+			field:      consts.FieldPasswordFile,
+			envVars:    []string{consts.EnvVarLDAPPasswordFile},
+			defaultVal: "",
+		},
+	}
+
+	if err := l.AuthLoginCommon.Init(d, authField,
+		func(data *schema.ResourceData, params map[string]interface{}) error {
+			return l.setDefaultFields(d, defaults, params)
+		},
+		func(data *schema.ResourceData, params map[string]interface{}) error {
+			return l.checkRequiredFields(d, params, consts.FieldUsername)
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// Method name for the ldap authentication engine.
+func (l *AuthLoginLDAP) Method() string {
+	return consts.AuthMethodLDAP
+}
+
+// Login using the ldap authentication engine.
+func (l *AuthLoginLDAP) Login(client *api.Client) (*api.Secret, error) {
+	if err := l.validate(); err != nil {
+		return nil, err
+	}
+
+	params, err := l.copyParamsExcluding(
+		consts.FieldUseRootNamespace,
+		consts.FieldNamespace,
+		consts.FieldMount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setupUsernamePasswordAuthParams(l.Method(), params); err != nil {
+		return nil, err
+	}
+
+	return l.login(client, l.LoginPath(), params)
+}
@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+func TestAuthLoginLDAP_Init(t *testing.T) {
+	tests := []authLoginInitTest{
+		{
+			name:      "basic",
+			authField: consts.FieldAuthLoginLDAP,
+			raw: map[string]interface{}{
+				consts.FieldAuthLoginLDAP: []interface{}{
+					map[string]interface{}{
+						consts.FieldNamespace: "ns1",
+						consts.FieldUsername:  "alice",
+						consts.FieldPassword:  "password1",
+					},
+				},
+			},
+			expectParams: map[string]interface{}{
+				consts.FieldNamespace:        "ns1",
+				consts.FieldUseRootNamespace: false,
+				consts.FieldMount:            consts.MountTypeLDAP,
+				consts.FieldUsername:         "alice",
+				consts.FieldPassword:         "password1",
+				consts.FieldPasswordFile:     "",
+			},
+			wantErr: false,
+		},
+		{
+			name:      "basic-with-env",
+			authField: consts.FieldAuthLoginLDAP,
+			raw: map[string]interface{}{
+				consts.FieldAuthLoginLDAP: []interface{}{
+					map[string]interface{}{
+						consts.FieldMount: "ldap1",
+					},
+				},
+			},
+			envVars: map[string]string{
+				consts.EnvVarLDAPUsername:     "alice",
+				consts.EnvVarLDAPPasswordFile: "/tmp/password",
+			},
+			expectParams: map[string]interface{}{
+				consts.FieldNamespace:        "",
+				consts.FieldUseRootNamespace: false,
+				consts.FieldMount:            "ldap1",
+				consts.FieldUsername:         "alice",
+				consts.FieldPassword:         "",
+				consts.FieldPasswordFile:     "/tmp/password",
+			},
+			wantErr: false,
+		},
+		{
+			name:         "error-missing-resource",
+			authField:    consts.FieldAuthLoginLDAP,
+			expectParams: nil,
+			wantErr:      true,
+			expectErr:    fmt.Errorf("resource data missing field %q", consts.FieldAuthLoginLDAP),
+		},
+		{
+			name:      "error-missing-required",
+			authField: consts.FieldAuthLoginLDAP,
+			raw: map[string]interface{}{
+				consts.FieldAuthLoginLDAP: []interface{}{
+					map[string]interface{}{},
+				},
+			},
+			expectParams: nil,
+			wantErr:      true,
+			expectErr: fmt.Errorf("required fields are unset: %v", []string{
+				consts.FieldUsername,
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := map[string]*schema.Schema{
+				tt.authField: GetLDAPLoginSchema(tt.authField),
+			}
+			assertAuthLoginInit(t, tt, s, &AuthLoginLDAP{})
+		})
+	}
+}
+
+func TestAuthLoginLDAP_LoginPath(t *testing.T) {
+	type fields struct {
+		AuthLoginCommon AuthLoginCommon
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   string
+	}{
+		{
+			name: "default",
+			fields: fields{
+				AuthLoginCommon{
+					params: map[string]interface{}{
+						consts.FieldUsername: "bob",
+					},
+				},
+			},
+			want: "auth/ldap/login/bob",
+		},
+		{
+			name: "other",
+			fields: fields{
+				AuthLoginCommon{
+					mount: "foo",
+					params: map[string]interface{}{
+						consts.FieldUsername: "bob",
+					},
+				},
+			},
+			want: "auth/foo/login/bob",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &AuthLoginLDAP{
+				AuthLoginCommon: tt.fields.AuthLoginCommon,
+			}
+			if got := l.LoginPath(); got != tt.want {
+				t.Errorf("LoginPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthLoginLDAP_Login(t *testing.T) {
+	handlerFunc := func(t *testLoginHandler, w http.ResponseWriter, req *http.Request) {
+		parts := strings.Split(req.URL.Path, "/")
+		m, err := json.Marshal(
+			&api.Secret{
+				Auth: &api.SecretAuth{
+					Metadata: map[string]string{
+						"username": parts[len(parts)-1],
+					},
+				},
+			},
+		)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if _, err := w.Write(m); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	passwordFile := filepath.Join(t.TempDir(), "password")
+	if err := os.WriteFile(passwordFile, []byte("password2"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []authLoginTest{
+		{
+			name: "basic",
+			authLogin: &AuthLoginLDAP{
+				AuthLoginCommon{
+					authField: consts.FieldAuthLoginLDAP,
+					mount:     "foo",
+					params: map[string]interface{}{
+						consts.FieldUsername:     "bob",
+						consts.FieldPassword:     "baz",
+						consts.FieldPasswordFile: "",
+					},
+					initialized: true,
+				},
+			},
+			handler: &testLoginHandler{
+				handlerFunc: handlerFunc,
+			},
+			expectReqCount: 1,
+			expectReqPaths: []string{
+				"/v1/auth/foo/login/bob",
+			},
+			expectReqParams: []map[string]interface{}{
+				{
+					consts.FieldUsername: "bob",
+					consts.FieldPassword: "baz",
+				},
+			},
+			want: &api.Secret{
+				Auth: &api.SecretAuth{
+					Metadata: map[string]string{
+						"username": "bob",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "password-file",
+			authLogin: &AuthLoginLDAP{
+				AuthLoginCommon{
+					authField: consts.FieldAuthLoginLDAP,
+					mount:     consts.MountTypeLDAP,
+					params: map[string]interface{}{
+						consts.FieldUsername:     "bob",
+						consts.FieldPassword:     "",
+						consts.FieldPasswordFile: passwordFile,
+					},
+					initialized: true,
+				},
+			},
+			handler: &testLoginHandler{
+				handlerFunc: handlerFunc,
+			},
+			expectReqCount: 1,
+			expectReqPaths: []string{
+				"/v1/auth/ldap/login/bob",
+			},
+			expectReqParams: []map[string]interface{}{
+				{
+					consts.FieldUsername: "bob",
+					consts.FieldPassword: "password2",
+				},
+			},
+			want: &api.Secret{
+				Auth: &api.SecretAuth{
+					Metadata: map[string]string{
+						"username": "bob",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "error-vault-token-set",
+			authLogin: &AuthLoginLDAP{
+				AuthLoginCommon{
+					authField: consts.FieldAuthLoginLDAP,
+					mount:     "foo",
+					params: map[string]interface{}{
+						consts.FieldUsername: "bob",
+						consts.FieldPassword: "baz",
+					},
+					initialized: true,
+				},
+			},
+			handler: &testLoginHandler{
+				handlerFunc: handlerFunc,
+			},
+			token:     "foo",
+			wantErr:   true,
+			expectErr: errors.New("vault login client has a token set"),
+		},
+		{
+			name: "error-uninitialized",
+			authLogin: &AuthLoginLDAP{
+				AuthLoginCommon{
+					initialized: false,
+				},
+			},
+			handler: &testLoginHandler{
+				handlerFunc: handlerFunc,
+			},
+			expectReqCount: 0,
+			want:           nil,
+			wantErr:        true,
+			expectErr:      authLoginInitCheckError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testAuthLogin(t, tt)
+		})
+	}
+}
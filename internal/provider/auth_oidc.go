@@ -70,7 +70,7 @@ type AuthLoginOIDC struct {
 	AuthLoginCommon
 }
 
-// MountPath for the cert authentication engine.
+// MountPath for the oidc authentication engine.
 func (l *AuthLoginOIDC) MountPath() string {
 	if l.mount == "" {
 		return l.Method()
@@ -107,6 +107,12 @@ func (l *AuthLoginOIDC) Login(client *api.Client) (*api.Secret, error) {
 		return nil, err
 	}
 
+	// the CLIHandler uses the client directly,
+	// so we need to perform the same check done in login().
+	if client.Token() != "" {
+		return nil, fmt.Errorf("vault login client has a token set")
+	}
+
 	params, err := l.getAuthParams()
 	if err != nil {
 		return nil, err
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -230,6 +231,25 @@ func TestAuthLoginOIDC_Login(t *testing.T) {
 	}
 
 	tests := []authLoginTest{
+		{
+			name: "error-vault-token-set",
+			authLogin: &AuthLoginOIDC{
+				AuthLoginCommon{
+					authField: consts.FieldAuthLoginOIDC,
+					params: map[string]interface{}{
+						consts.FieldRole: "alice",
+					},
+					initialized: true,
+				},
+			},
+			handler: &testLoginHandler{
+				handlerFunc: handlerFunc,
+			},
+			token:     "foo",
+			want:      nil,
+			wantErr:   true,
+			expectErr: errors.New("vault login client has a token set"),
+		},
 		{
 			name: "error-uninitialized",
 			authLogin: &AuthLoginOIDC{
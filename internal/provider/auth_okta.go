@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+func init() {
+	field := consts.FieldAuthLoginOkta
+	if err := globalAuthLoginRegistry.Register(field,
+		func(r *schema.ResourceData) (AuthLogin, error) {
+			a := &AuthLoginOkta{}
+			return a.Init(r, field)
+		}, GetOktaLoginSchema); err != nil {
+		panic(err)
+	}
+}
+
+// GetOktaLoginSchema for the okta authentication engine.
+func GetOktaLoginSchema(authField string) *schema.Schema {
+	return getLoginSchema(
+		authField,
+		"Login to vault using the okta method",
+		GetOktaLoginSchemaResource,
+	)
+}
+
+// GetOktaLoginSchemaResource for the okta authentication engine.
+func GetOktaLoginSchemaResource(authField string) *schema.Resource {
+	return mustAddLoginSchema(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			consts.FieldUsername: {
+				Type: schema.TypeString,
+				// can be set via an env var
+				Optional:    true,
+				Description: "The Okta username.",
+			},
+			consts.FieldPassword: {
+				Type: schema.TypeString,
+				// can be set via an env var
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The Okta password.",
+			},
+			consts.FieldTOTP: {
+				Type: schema.TypeString,
+				// can be set via an env var
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The Okta Verify TOTP passcode, required when MFA is enforced.",
+			},
+		},
+	}, authField, consts.MountTypeOkta)
+}
+
+var _ AuthLogin = (*AuthLoginOkta)(nil)
+
+// AuthLoginOkta provides an interface for authenticating to the
+// okta authentication engine.
+// Requires configuration provided by SchemaLoginOkta.
+type AuthLoginOkta struct {
+	AuthLoginCommon
+}
+
+// MountPath for the okta authentication engine.
+func (l *AuthLoginOkta) MountPath() string {
+	if l.mount == "" {
+		return l.Method()
+	}
+	return l.mount
+}
+
+// LoginPath for the okta authentication engine.
+func (l *AuthLoginOkta) LoginPath() string {
+	return fmt.Sprintf("auth/%s/login/%s", l.MountPath(), l.params[consts.FieldUsername])
+}
+
+func (l *AuthLoginOkta) Init(d *schema.ResourceData, authField string) (AuthLogin, error) {
+	defaults := authDefaults{
+		{
+			field:      consts.FieldUsername,
+			envVars:    []string{consts.EnvVarOktaUsername},
+			defaultVal: "",
+		},
+		{
+			field:      consts.FieldPassword,
+			envVars:    []string{consts.EnvVarOktaPassword},
+			defaultVal: "",
+		},
+		{
+			field:      consts.FieldTOTP,
+			envVars:    []string{consts.EnvVarOktaTOTP},
+			defaultVal: "",
+		},
+	}
+
+	if err := l.AuthLoginCommon.Init(d, authField,
+		func(data *schema.ResourceData, params map[string]interface{}) error {
+			return l.setDefaultFields(d, defaults, params)
+		},
+		func(data *schema.ResourceData, params map[string]interface{}) error {
+			return l.checkRequiredFields(d, params, consts.FieldUsername, consts.FieldPassword)
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// Method name for the okta authentication engine.
+func (l *AuthLoginOkta) Method() string {
+	return consts.AuthMethodOkta
+}
+
+// Login using the okta authentication engine.
+func (l *AuthLoginOkta) Login(client *api.Client) (*api.Secret, error) {
+	if err := l.validate(); err != nil {
+		return nil, err
+	}
+
+	params, err := l.copyParamsExcluding(
+		consts.FieldUseRootNamespace,
+		consts.FieldNamespace,
+		consts.FieldMount,
+		consts.FieldUsername,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// the totp is only required when MFA is enforced
+	if v, ok := params[consts.FieldTOTP]; ok && v == "" {
+		delete(params, consts.FieldTOTP)
+	}
+
+	return l.login(client, l.LoginPath(), params)
+}
@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+func TestAuthLoginOkta_Init(t *testing.T) {
+	tests := []authLoginInitTest{
+		{
+			name:      "basic",
+			authField: consts.FieldAuthLoginOkta,
+			raw: map[string]interface{}{
+				consts.FieldAuthLoginOkta: []interface{}{
+					map[string]interface{}{
+						consts.FieldNamespace: "ns1",
+						consts.FieldUsername:  "alice",
+						consts.FieldPassword:  "password1",
+						consts.FieldTOTP:      "123456",
+					},
+				},
+			},
+			expectParams: map[string]interface{}{
+				consts.FieldNamespace:        "ns1",
+				consts.FieldUseRootNamespace: false,
+				consts.FieldMount:            consts.MountTypeOkta,
+				consts.FieldUsername:         "alice",
+				consts.FieldPassword:         "password1",
+				consts.FieldTOTP:             "123456",
+			},
+			wantErr: false,
+		},
+		{
+			name:      "basic-with-env",
+			authField: consts.FieldAuthLoginOkta,
+			raw: map[string]interface{}{
+				consts.FieldAuthLoginOkta: []interface{}{
+					map[string]interface{}{
+						consts.FieldMount: "okta1",
+					},
+				},
+			},
+			envVars: map[string]string{
+				consts.EnvVarOktaUsername: "alice",
+				consts.EnvVarOktaPassword: "password1",
+			},
+			expectParams: map[string]interface{}{
+				consts.FieldNamespace:        "",
+				consts.FieldUseRootNamespace: false,
+				consts.FieldMount:            "okta1",
+				consts.FieldUsername:         "alice",
+				consts.FieldPassword:         "password1",
+				consts.FieldTOTP:             "",
+			},
+			wantErr: false,
+		},
+		{
+			name:         "error-missing-resource",
+			authField:    consts.FieldAuthLoginOkta,
+			expectParams: nil,
+			wantErr:      true,
+			expectErr:    fmt.Errorf("resource data missing field %q", consts.FieldAuthLoginOkta),
+		},
+		{
+			name:      "error-missing-required",
+			authField: consts.FieldAuthLoginOkta,
+			raw: map[string]interface{}{
+				consts.FieldAuthLoginOkta: []interface{}{
+					map[string]interface{}{
+						consts.FieldUsername: "alice",
+					},
+				},
+			},
+			expectParams: nil,
+			wantErr:      true,
+			expectErr: fmt.Errorf("required fields are unset: %v", []string{
+				consts.FieldPassword,
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := map[string]*schema.Schema{
+				tt.authField: GetOktaLoginSchema(tt.authField),
+			}
+			assertAuthLoginInit(t, tt, s, &AuthLoginOkta{})
+		})
+	}
+}
+
+func TestAuthLoginOkta_LoginPath(t *testing.T) {
+	type fields struct {
+		AuthLoginCommon AuthLoginCommon
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   string
+	}{
+		{
+			name: "default",
+			fields: fields{
+				AuthLoginCommon{
+					params: map[string]interface{}{
+						consts.FieldUsername: "bob",
+					},
+				},
+			},
+			want: "auth/okta/login/bob",
+		},
+		{
+			name: "other",
+			fields: fields{
+				AuthLoginCommon{
+					mount: "foo",
+					params: map[string]interface{}{
+						consts.FieldUsername: "bob",
+					},
+				},
+			},
+			want: "auth/foo/login/bob",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &AuthLoginOkta{
+				AuthLoginCommon: tt.fields.AuthLoginCommon,
+			}
+			if got := l.LoginPath(); got != tt.want {
+				t.Errorf("LoginPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthLoginOkta_Login(t *testing.T) {
+	handlerFunc := func(t *testLoginHandler, w http.ResponseWriter, req *http.Request) {
+		parts := strings.Split(req.URL.Path, "/")
+		m, err := json.Marshal(
+			&api.Secret{
+				Auth: &api.SecretAuth{
+					Metadata: map[string]string{
+						"username": parts[len(parts)-1],
+					},
+				},
+			},
+		)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if _, err := w.Write(m); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	tests := []authLoginTest{
+		{
+			name: "basic",
+			authLogin: &AuthLoginOkta{
+				AuthLoginCommon{
+					authField: consts.FieldAuthLoginOkta,
+					mount:     "foo",
+					params: map[string]interface{}{
+						consts.FieldUsername: "bob",
+						consts.FieldPassword: "baz",
+						consts.FieldTOTP:     "",
+					},
+					initialized: true,
+				},
+			},
+			handler: &testLoginHandler{
+				handlerFunc: handlerFunc,
+			},
+			expectReqCount: 1,
+			expectReqPaths: []string{
+				"/v1/auth/foo/login/bob",
+			},
+			expectReqParams: []map[string]interface{}{
+				{
+					consts.FieldPassword: "baz",
+				},
+			},
+			want: &api.Secret{
+				Auth: &api.SecretAuth{
+					Metadata: map[string]string{
+						"username": "bob",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "with-totp",
+			authLogin: &AuthLoginOkta{
+				AuthLoginCommon{
+					authField: consts.FieldAuthLoginOkta,
+					mount:     consts.MountTypeOkta,
+					params: map[string]interface{}{
+						consts.FieldUsername: "bob",
+						consts.FieldPassword: "baz",
+						consts.FieldTOTP:     "123456",
+					},
+					initialized: true,
+				},
+			},
+			handler: &testLoginHandler{
+				handlerFunc: handlerFunc,
+			},
+			expectReqCount: 1,
+			expectReqPaths: []string{
+				"/v1/auth/okta/login/bob",
+			},
+			expectReqParams: []map[string]interface{}{
+				{
+					consts.FieldPassword: "baz",
+					consts.FieldTOTP:     "123456",
+				},
+			},
+			want: &api.Secret{
+				Auth: &api.SecretAuth{
+					Metadata: map[string]string{
+						"username": "bob",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "error-vault-token-set",
+			authLogin: &AuthLoginOkta{
+				AuthLoginCommon{
+					authField: consts.FieldAuthLoginOkta,
+					mount:     "foo",
+					params: map[string]interface{}{
+						consts.FieldUsername: "bob",
+						consts.FieldPassword: "baz",
+					},
+					initialized: true,
+				},
+			},
+			handler: &testLoginHandler{
+				handlerFunc: handlerFunc,
+			},
+			token:     "foo",
+			wantErr:   true,
+			expectErr: errors.New("vault login client has a token set"),
+		},
+		{
+			name: "error-uninitialized",
+			authLogin: &AuthLoginOkta{
+				AuthLoginCommon{
+					initialized: false,
+				},
+			},
+			handler: &testLoginHandler{
+				handlerFunc: handlerFunc,
+			},
+			expectReqCount: 0,
+			want:           nil,
+			wantErr:        true,
+			expectErr:      authLoginInitCheckError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testAuthLogin(t, tt)
+		})
+	}
+}
@@ -46,6 +46,7 @@ func GetRadiusLoginSchemaResource(authField string) *schema.Resource {
 				Type: schema.TypeString,
 				// can be set via an env var
 				Optional:    true,
+				Sensitive:   true,
 				Description: "The Radius password for username.",
 			},
 		},
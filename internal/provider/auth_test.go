@@ -21,7 +21,7 @@ import (
 
 // expectedRegisteredAuthLogin value should be modified when adding
 // registering/de-registering AuthLogin resources.
-const expectedRegisteredAuthLogin = 12
+const expectedRegisteredAuthLogin = 17
 
 type authLoginTest struct {
 	name               string
@@ -240,6 +240,98 @@ func TestGetAuthLogin_registered(t *testing.T) {
 	}
 }
 
+func TestGetAuthLoginFields(t *testing.T) {
+	userpass := []interface{}{
+		map[string]interface{}{
+			consts.FieldUsername: "bob",
+		},
+	}
+	approle := []interface{}{
+		map[string]interface{}{
+			consts.FieldRoleID: "role-id",
+		},
+	}
+
+	tests := []struct {
+		name    string
+		raw     map[string]interface{}
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "none",
+			raw:  map[string]interface{}{},
+		},
+		{
+			name: "single",
+			raw: map[string]interface{}{
+				consts.FieldAuthLoginUserpass: userpass,
+			},
+			want: []string{consts.FieldAuthLoginUserpass},
+		},
+		{
+			name: "multiple-with-priority",
+			raw: map[string]interface{}{
+				consts.FieldAuthLoginUserpass: userpass,
+				consts.FieldAuthLoginAppRole:  approle,
+				consts.FieldAuthLoginPriority: []interface{}{
+					consts.FieldAuthLoginUserpass,
+					consts.FieldAuthLoginAppRole,
+					consts.FieldToken,
+				},
+			},
+			want: []string{
+				consts.FieldAuthLoginUserpass,
+				consts.FieldAuthLoginAppRole,
+				consts.FieldToken,
+			},
+		},
+		{
+			name: "error-multiple-without-priority",
+			raw: map[string]interface{}{
+				consts.FieldAuthLoginUserpass: userpass,
+				consts.FieldAuthLoginAppRole:  approle,
+			},
+			wantErr: true,
+		},
+		{
+			name: "error-priority-not-configured",
+			raw: map[string]interface{}{
+				consts.FieldAuthLoginUserpass: userpass,
+				consts.FieldAuthLoginPriority: []interface{}{
+					consts.FieldAuthLoginUserpass,
+					consts.FieldAuthLoginAppRole,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error-configured-not-in-priority",
+			raw: map[string]interface{}{
+				consts.FieldAuthLoginUserpass: userpass,
+				consts.FieldAuthLoginAppRole:  approle,
+				consts.FieldAuthLoginPriority: []interface{}{
+					consts.FieldAuthLoginAppRole,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, NewProvider(nil, nil).Schema, tt.raw)
+			got, err := GetAuthLoginFields(d)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetAuthLoginFields() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetAuthLoginFields() got = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
 func assertAuthLoginEqual(t *testing.T, expected, actual AuthLogin) {
 	t.Helper()
 	if !reflect.DeepEqual(expected, actual) {
@@ -132,7 +132,12 @@ func (l *AuthLoginTokenFile) Login(c *api.Client) (*api.Secret, error) {
 }
 
 func (l *AuthLoginTokenFile) readTokenFile() (string, error) {
-	filename := l.params[consts.FieldFilename].(string)
+	return readTokenFile(l.params[consts.FieldFilename].(string))
+}
+
+// readTokenFile reads a Vault token from filename, which must be a regular file
+// that is only accessible by its owner, and contain a single line.
+func readTokenFile(filename string) (string, error) {
 	fh, err := os.Open(filename)
 	if err != nil {
 		return "", err
@@ -151,7 +156,8 @@ func (l *AuthLoginTokenFile) readTokenFile() (string, error) {
 	// require only user access
 	v := mode.Perm() & 0o077
 	if v != 0 {
-		return "", fmt.Errorf("token path %q has an invalid mode %v", filename, mode.Perm())
+		return "", fmt.Errorf("token path %q has an invalid mode %v, "+
+			"it must only be accessible by its owner, e.g. 0600", filename, mode.Perm())
 	}
 
 	var lines [][]byte
@@ -48,6 +48,7 @@ func GetUserpassLoginSchemaResource(authField string) *schema.Resource {
 			consts.FieldPassword: {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Sensitive:   true,
 				Description: "Login with password",
 			},
 			consts.FieldPasswordFile: {
@@ -132,16 +133,16 @@ func (l *AuthLoginUserpass) Login(client *api.Client) (*api.Secret, error) {
 		return nil, err
 	}
 
-	if err := setupUserpassAuthParams(params); err != nil {
+	if err := setupUsernamePasswordAuthParams(l.Method(), params); err != nil {
 		return nil, err
 	}
 
 	return l.login(client, l.LoginPath(), params)
 }
 
-func setupUserpassAuthParams(params map[string]interface{}) error {
-	method := consts.AuthMethodUserpass
-
+// setupUsernamePasswordAuthParams sets up the params for auth methods that
+// login with a username and password, e.g. userpass and ldap.
+func setupUsernamePasswordAuthParams(method string, params map[string]interface{}) error {
 	v, ok := params[consts.FieldUsername]
 	if !ok {
 		return fmt.Errorf("auth method %q, %q not set in %q",
@@ -153,19 +154,18 @@ func setupUserpassAuthParams(params map[string]interface{}) error {
 
 	username := v.(string)
 
-	// the password can be had from various sources
+	// the password can be had from various sources,
+	// unset fields are initialized to their zero value.
 	var p string
 	var passwordFile string
-	if v, ok := params[consts.FieldPassword]; ok && v != nil {
-		p = v.(string)
-	} else if v, ok := params[consts.FieldPasswordFile]; ok && v != nil {
-		passwordFile = v.(string)
-		delete(params, consts.FieldPasswordFile)
+	if v, ok := params[consts.FieldPassword].(string); ok {
+		p = v
 	}
 
-	if v, ok := params[consts.FieldPassword]; ok && v != nil {
-		p = v.(string)
+	if v, ok := params[consts.FieldPasswordFile].(string); ok {
+		passwordFile = v
 	}
+	delete(params, consts.FieldPasswordFile)
 
 	if passwordFile != "" && p != "" {
 		return fmt.Errorf("auth method %q, mutually exclusive auth params provided: %s",
@@ -178,6 +178,7 @@ func setupUserpassAuthParams(params map[string]interface{}) error {
 		if err != nil {
 			return err
 		}
+		defer f.Close()
 
 		v, err := ioutil.ReadAll(f)
 		if err != nil {
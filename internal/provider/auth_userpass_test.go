@@ -142,7 +142,7 @@ func TestAuthLoginUserPass_Init(t *testing.T) {
 	}
 }
 
-func Test_setupUserpassAuthParams(t *testing.T) {
+func Test_setupUsernamePasswordAuthParams(t *testing.T) {
 	tests := []struct {
 		name    string
 		params  map[string]interface{}
@@ -162,6 +162,31 @@ func Test_setupUserpassAuthParams(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "password-file-empty-password",
+			params: map[string]interface{}{
+				consts.FieldUsername:     "bob",
+				consts.FieldPassword:     "",
+				consts.FieldPasswordFile: "",
+			},
+			want: map[string]interface{}{
+				consts.FieldUsername: "bob",
+				consts.FieldPassword: "foobar",
+			},
+			wantErr: false,
+		},
+		{
+			name: "password",
+			params: map[string]interface{}{
+				consts.FieldUsername: "bob",
+				consts.FieldPassword: "baz",
+			},
+			want: map[string]interface{}{
+				consts.FieldUsername: "bob",
+				consts.FieldPassword: "baz",
+			},
+			wantErr: false,
+		},
 		{
 			name: "error-no-username",
 			params: map[string]interface{}{
@@ -188,12 +213,12 @@ func Test_setupUserpassAuthParams(t *testing.T) {
 				}
 			}
 
-			if err := setupUserpassAuthParams(tt.params); (err != nil) != tt.wantErr {
-				t.Errorf("setupUserpassAuthParams() error = %v, wantErr %v", err, tt.wantErr)
+			if err := setupUsernamePasswordAuthParams(consts.AuthMethodUserpass, tt.params); (err != nil) != tt.wantErr {
+				t.Errorf("setupUsernamePasswordAuthParams() error = %v, wantErr %v", err, tt.wantErr)
 			}
 
 			if !reflect.DeepEqual(tt.want, tt.params) {
-				t.Errorf("setupUserpassAuthParams() want = %v, actual %v", tt.want, tt.params)
+				t.Errorf("setupUsernamePasswordAuthParams() want = %v, actual %v", tt.want, tt.params)
 			}
 		})
 	}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+func AuthLoginAppRoleSchema() schema.Block {
+	return mustAddLoginSchema(&schema.ListNestedBlock{
+		Description: "Login to vault using the approle method",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				consts.FieldRoleID: schema.StringAttribute{
+					Description: "The RoleID of the AppRole.",
+					// can be set via an env var
+					Optional: true,
+				},
+				consts.FieldSecretID: schema.StringAttribute{
+					// can be set via an env var
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The SecretID of the AppRole, required unless bind_secret_id is disabled on the role.",
+				},
+			},
+		},
+	}, consts.MountTypeAppRole)
+}
@@ -4,7 +4,10 @@
 package fwprovider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 )
@@ -22,6 +25,21 @@ func AuthLoginJWTSchema() schema.Block {
 					// can be set via an env var
 					Optional:    true,
 					Description: "A signed JSON Web Token.",
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(
+							path.MatchRelative().AtParent().AtName(consts.FieldJWTFile),
+						),
+					},
+				},
+				consts.FieldJWTFile: schema.StringAttribute{
+					// can be set via an env var
+					Optional:    true,
+					Description: "Path to a file containing a signed JSON Web Token.",
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(
+							path.MatchRelative().AtParent().AtName(consts.FieldJWT),
+						),
+					},
 				},
 			},
 		},
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+func AuthLoginKubernetesSchema() schema.Block {
+	return mustAddLoginSchema(&schema.ListNestedBlock{
+		Description: "Login to vault using the kubernetes method",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				consts.FieldRole: schema.StringAttribute{
+					Required:    true,
+					Description: "Name of the login role.",
+				},
+				consts.FieldJWT: schema.StringAttribute{
+					// can be set via an env var
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The service account JWT, takes precedence over jwt_file.",
+				},
+				consts.FieldJWTFile: schema.StringAttribute{
+					Optional:    true,
+					Description: "Path to a file containing the service account JWT.",
+				},
+			},
+		},
+	}, consts.MountTypeKubernetes)
+}
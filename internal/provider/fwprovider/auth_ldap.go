@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+func AuthLoginLDAPSchema() schema.Block {
+	return mustAddLoginSchema(&schema.ListNestedBlock{
+		Description: "Login to vault using the ldap method",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				consts.FieldUsername: schema.StringAttribute{
+					// can be set via an env var
+					Optional:    true,
+					Description: "The LDAP username.",
+				},
+				consts.FieldPassword: schema.StringAttribute{
+					// can be set via an env var
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The LDAP password.",
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(
+							path.MatchRelative().AtParent().AtName(consts.FieldPasswordFile),
+						),
+					},
+				},
+				consts.FieldPasswordFile: schema.StringAttribute{
+					// can be set via an env var
+					Optional:    true,
+					Description: "Path to a file containing the LDAP password.",
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(
+							path.MatchRelative().AtParent().AtName(consts.FieldPassword),
+						),
+					},
+				},
+			},
+		},
+	}, consts.MountTypeLDAP)
+}
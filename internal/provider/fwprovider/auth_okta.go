@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+func AuthLoginOktaSchema() schema.Block {
+	return mustAddLoginSchema(&schema.ListNestedBlock{
+		Description: "Login to vault using the okta method",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				consts.FieldUsername: schema.StringAttribute{
+					// can be set via an env var
+					Optional:    true,
+					Description: "The Okta username.",
+				},
+				consts.FieldPassword: schema.StringAttribute{
+					// can be set via an env var
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The Okta password.",
+				},
+				consts.FieldTOTP: schema.StringAttribute{
+					// can be set via an env var
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The Okta Verify TOTP passcode, required when MFA is enforced.",
+				},
+			},
+		},
+	}, consts.MountTypeOkta)
+}
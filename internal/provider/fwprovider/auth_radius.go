@@ -22,6 +22,7 @@ func AuthLoginRadiusSchema() schema.Block {
 				consts.FieldPassword: schema.StringAttribute{
 					// can be set via an env var
 					Optional:    true,
+					Sensitive:   true,
 					Description: "The Radius password for username.",
 				},
 			},
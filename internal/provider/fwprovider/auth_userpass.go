@@ -24,6 +24,7 @@ func AuthLoginUserpassSchema() schema.Block {
 				},
 				consts.FieldPassword: schema.StringAttribute{
 					Optional:    true,
+					Sensitive:   true,
 					Description: "Login with password",
 					Validators: []validator.String{
 						stringvalidator.ConflictsWith(
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/auth/spiffe"
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/azure"
 	ephemeralsecrets "github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/ephemeral"
+	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/kv"
+	ephemeralsys "github.com/hashicorp/terraform-provider-vault/internal/vault/sys/ephemeral"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -20,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	sdkv2provider "github.com/hashicorp/terraform-provider-vault/internal/provider"
@@ -67,7 +70,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 			// return an error.
 			consts.FieldAddress: schema.StringAttribute{
 				Optional:    true,
-				Description: "URL of the root of the target Vault server.",
+				Description: "URL of the root of the target Vault server, or a unix:// socket path.",
+			},
+			consts.FieldProxyURL: schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of the HTTP or HTTPS proxy to use when connecting to Vault.",
 			},
 			"add_address_to_env": schema.StringAttribute{
 				Optional:    true,
@@ -79,6 +86,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Token to use to authenticate to Vault.",
 			},
+			consts.FieldAuthLoginPriority: schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The order in which to attempt the configured auth login methods, by block name. May include token to fall back to the provider token.",
+			},
 			"token_name": schema.StringAttribute{
 				Optional:    true,
 				Description: "Token name to use for creating the Vault child token.",
@@ -90,6 +102,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				// Note that this is strongly discouraged due to the potential of exposing sensitive secret data.
 				Description: "Set this to true to prevent the creation of ephemeral child token used by this provider.",
 			},
+			consts.FieldRevokeChildToken: schema.BoolAttribute{
+				Optional:    true,
+				Description: "Set this to true to revoke the ephemeral child token when the provider shuts down.",
+			},
 			consts.FieldCACertFile: schema.StringAttribute{
 				Optional:    true,
 				Description: "Path to a CA certificate file to validate the server's certificate.",
@@ -118,6 +134,18 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Maximum number of retries for Client Controlled Consistency related operations",
 			},
+			consts.FieldMinRetryWait: schema.StringAttribute{
+				Optional:    true,
+				Description: "Minimum time to wait before retrying a request, as a duration string.",
+			},
+			consts.FieldMaxRetryWait: schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum time to wait before retrying a request, as a duration string.",
+			},
+			consts.FieldRequestTimeout: schema.StringAttribute{
+				Optional:    true,
+				Description: "Timeout for each request made to Vault, as a duration string.",
+			},
 			consts.FieldNamespace: schema.StringAttribute{
 				Optional:    true,
 				Description: "The namespace to use. Available only for Vault Enterprise.",
@@ -126,6 +154,14 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Skip the dynamic fetching of the Vault server version.",
 			},
+			consts.FieldSkipValidation: schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip the validation of the provider configuration when the provider is configured.",
+			},
+			consts.FieldCheckConnectivity: schema.BoolAttribute{
+				Optional:    true,
+				Description: "Check the connectivity to Vault, and detect the Vault server version, when the provider is configured.",
+			},
 			consts.FieldVaultVersionOverride: schema.StringAttribute{
 				Optional: true,
 				Description: "Override the Vault server version, " +
@@ -181,18 +217,23 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					listvalidator.SizeAtMost(1),
 				},
 			},
-			consts.FieldAuthLoginAWS:       AuthLoginAWSSchema(),
-			consts.FieldAuthLoginAzure:     AuthLoginAzureSchema(),
-			consts.FieldAuthLoginCert:      AuthLoginCertSchema(),
-			consts.FieldAuthLoginGCP:       AuthLoginGCPSchema(),
-			consts.FieldAuthLoginGeneric:   AuthLoginGenericSchema(),
-			consts.FieldAuthLoginJWT:       AuthLoginJWTSchema(),
-			consts.FieldAuthLoginKerberos:  AuthLoginKerberosSchema(),
-			consts.FieldAuthLoginOCI:       AuthLoginOCISchema(),
-			consts.FieldAuthLoginOIDC:      AuthLoginOIDCSchema(),
-			consts.FieldAuthLoginRadius:    AuthLoginRadiusSchema(),
-			consts.FieldAuthLoginTokenFile: AuthLoginTokenFileSchema(),
-			consts.FieldAuthLoginUserpass:  AuthLoginUserpassSchema(),
+			consts.FieldAuthLoginAppRole:    AuthLoginAppRoleSchema(),
+			consts.FieldAuthLoginAWS:        AuthLoginAWSSchema(),
+			consts.FieldAuthLoginAzure:      AuthLoginAzureSchema(),
+			consts.FieldAuthLoginCert:       AuthLoginCertSchema(),
+			consts.FieldAuthLoginGCP:        AuthLoginGCPSchema(),
+			consts.FieldAuthLoginGeneric:    AuthLoginGenericSchema(),
+			consts.FieldAuthLoginGitHub:     AuthLoginGitHubSchema(),
+			consts.FieldAuthLoginJWT:        AuthLoginJWTSchema(),
+			consts.FieldAuthLoginKerberos:   AuthLoginKerberosSchema(),
+			consts.FieldAuthLoginKubernetes: AuthLoginKubernetesSchema(),
+			consts.FieldAuthLoginLDAP:       AuthLoginLDAPSchema(),
+			consts.FieldAuthLoginOCI:        AuthLoginOCISchema(),
+			consts.FieldAuthLoginOIDC:       AuthLoginOIDCSchema(),
+			consts.FieldAuthLoginOkta:       AuthLoginOktaSchema(),
+			consts.FieldAuthLoginRadius:     AuthLoginRadiusSchema(),
+			consts.FieldAuthLoginTokenFile:  AuthLoginTokenFileSchema(),
+			consts.FieldAuthLoginUserpass:   AuthLoginUserpassSchema(),
 		},
 	}
 }
@@ -230,6 +271,10 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 		spiffe.NewSpiffeAuthRoleResource,
 		sys.NewPasswordPolicyResource,
 		azure.NewAzureStaticRoleResource,
+		kv.NewKVSecretMetadataResource,
+		kv.NewKVSecretVersionStateResource,
+		kv.NewKVSecretFieldsResource,
+		kv.NewKVSecretsResource,
 	}
 }
 
@@ -243,6 +288,8 @@ func (p *fwprovider) EphemeralResources(_ context.Context) []func() ephemeral.Ep
 		ephemeralsecrets.NewGCPOAuth2AccessTokenEphemeralResource,
 		ephemeralsecrets.NewAWSAccessCredentialsEphemeralSecretResource,
 		ephemeralsecrets.NewAWSStaticAccessCredentialsEphemeralSecretResource,
+		ephemeralsecrets.NewTransitDataKeyEphemeralResource,
+		ephemeralsys.NewRandomBytesEphemeralResource,
 	}
 
 }
@@ -4,17 +4,22 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
@@ -23,6 +28,7 @@ import (
 	terraformplugintesting "github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/vault/api"
 	config "github.com/hashicorp/vault/api/cliconfig"
+	"github.com/hashicorp/vault/api/tokenhelper"
 	"k8s.io/utils/pointer"
 
 	"github.com/hashicorp/terraform-provider-vault/helper"
@@ -32,6 +38,20 @@ import (
 const (
 	DefaultMaxHTTPRetries = 2
 	enterpriseMetadata    = "ent"
+	unixSocketScheme      = "unix://"
+	licenseStatusPath     = "sys/license/status"
+)
+
+// Capability is a Vault Enterprise feature that must be included in the
+// Vault server's license. Its value is the name of the license feature.
+type Capability string
+
+const (
+	CapabilityNamespaces             Capability = "Namespaces"
+	CapabilitySentinel               Capability = "Sentinel"
+	CapabilityPerformanceReplication Capability = "Performance Replication"
+	CapabilityKMIP                   Capability = "KMIP"
+	CapabilityTransform              Capability = "Transform Secrets Engine"
 )
 
 var (
@@ -61,7 +81,11 @@ type ProviderMeta struct {
 	resourceData *schema.ResourceData
 	clientCache  map[string]*api.Client
 	vaultVersion *version.Version
-	mu           sync.RWMutex
+	// capabilities holds the features of the Vault server's license, it is
+	// nil when the license could not be read.
+	capabilities      map[Capability]bool
+	capabilitiesProbe bool
+	mu                sync.RWMutex
 }
 
 // GetClient returns the providers default Vault client.
@@ -103,8 +127,10 @@ func (p *ProviderMeta) GetNSClient(ns string) (*api.Client, error) {
 		return nil, fmt.Errorf("empty namespace not allowed")
 	}
 
-	if root, ok := p.resourceData.GetOk(consts.FieldNamespace); ok && root.(string) != "" {
-		ns = fmt.Sprintf("%s/%s", root, ns)
+	if v, ok := p.resourceData.GetOk(consts.FieldNamespace); ok {
+		if root := strings.Trim(v.(string), "/"); root != "" {
+			ns = fmt.Sprintf("%s/%s", root, ns)
+		}
 	}
 
 	if p.clientCache == nil {
@@ -167,6 +193,79 @@ func (p *ProviderMeta) GetVaultVersion() *version.Version {
 	return p.vaultVersion
 }
 
+// GetCapabilities returns the Vault Enterprise capabilities included in the
+// Vault server's license. The license is only read once, nil is returned when
+// it could not be read, e.g. when the token lacks the required policy.
+func (p *ProviderMeta) GetCapabilities() map[Capability]bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.capabilitiesProbe {
+		p.capabilitiesProbe = true
+		capabilities, err := p.getCapabilities()
+		if err != nil {
+			log.Printf("[WARN] Could not determine the Vault Enterprise capabilities, err=%s", err)
+		}
+		p.capabilities = capabilities
+	}
+
+	return p.capabilities
+}
+
+// getCapabilities reads the features of the Vault server's license. Must be
+// called with ProviderMeta.mu
+func (p *ProviderMeta) getCapabilities() (map[Capability]bool, error) {
+	client, err := p.getClient()
+	if err != nil {
+		return nil, err
+	}
+
+	clone, err := client.Clone()
+	if err != nil {
+		return nil, err
+	}
+
+	clone.ClearNamespace()
+	resp, err := clone.Logical().Read(licenseStatusPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil {
+		return nil, fmt.Errorf("no license status found at %q", licenseStatusPath)
+	}
+
+	license, ok := resp.Data["autoloaded"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("no autoloaded license found at %q", licenseStatusPath)
+	}
+
+	features, ok := license[consts.FieldFeatures].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("no license features found at %q", licenseStatusPath)
+	}
+
+	capabilities := make(map[Capability]bool, len(features))
+	for _, v := range features {
+		if f, ok := v.(string); ok {
+			capabilities[Capability(f)] = true
+		}
+	}
+
+	return capabilities, nil
+}
+
+// skipGetVaultVersion returns true when the provider is configured to not
+// determine the Vault server version, in which case it is unknown.
+func (p *ProviderMeta) skipGetVaultVersion() bool {
+	if p.resourceData == nil {
+		return false
+	}
+
+	v, _ := p.resourceData.Get(consts.FieldSkipGetVaultVersion).(bool)
+	return v
+}
+
 func (p *ProviderMeta) validate() error {
 	if p.client == nil {
 		return fmt.Errorf("root api.Client not set, init with NewProviderMeta()")
@@ -194,30 +293,35 @@ func (p *ProviderMeta) setClient() error {
 	d := p.resourceData
 	clientConfig := api.DefaultConfig()
 
-	addr := GetResourceDataStr(d, consts.FieldAddress, api.EnvVaultAddress, "")
+	// a local Vault Agent or Vault Proxy may handle authentication on behalf of
+	// the provider, in which case a token is not required.
+	addr, agentAddr := getVaultAddress(d)
 	if addr == "" {
 		return fmt.Errorf("failed to configure Vault address")
 	}
 	clientConfig.Address = addr
+	// the address was already resolved from VAULT_AGENT_ADDR above, clear it
+	// so that it does not take precedence over the configured address.
+	clientConfig.AgentAddress = ""
 
 	clientConfig.CloneTLSConfig = true
 
-	tlsConfig := &api.TLSConfig{
-		CACert:        GetResourceDataStr(d, consts.FieldCACertFile, api.EnvVaultCACert, ""),
-		CAPath:        GetResourceDataStr(d, consts.FieldCACertDir, api.EnvVaultCAPath, ""),
-		Insecure:      GetResourceDataBool(d, consts.FieldSkipTLSVerify, "VAULT_SKIP_VERIFY", false),
-		TLSServerName: GetResourceDataStr(d, consts.FieldTLSServerName, api.EnvVaultTLSServerName, ""),
+	err := clientConfig.ConfigureTLS(getTLSConfig(d))
+	if err != nil {
+		return fmt.Errorf("failed to configure TLS for Vault API: %s", err)
 	}
 
-	if _, ok := d.GetOk(consts.FieldClientAuth); ok {
-		prefix := fmt.Sprintf("%s.0.", consts.FieldClientAuth)
-		tlsConfig.ClientCert = GetResourceDataStr(d, prefix+consts.FieldCertFile, api.EnvVaultClientCert, "")
-		tlsConfig.ClientKey = GetResourceDataStr(d, prefix+consts.FieldKeyFile, api.EnvVaultClientKey, "")
+	// must be done before the transport is wrapped below,
+	// since the api.Client can only configure an *http.Transport.
+	if err := configureUnixSocket(clientConfig); err != nil {
+		return err
 	}
 
-	err := clientConfig.ConfigureTLS(tlsConfig)
-	if err != nil {
-		return fmt.Errorf("failed to configure TLS for Vault API: %s", err)
+	if !strings.HasPrefix(addr, unixSocketScheme) {
+		proxyURL := GetResourceDataStr(d, consts.FieldProxyURL, "", "")
+		if err := configureProxy(clientConfig, proxyURL); err != nil {
+			return err
+		}
 	}
 
 	clientConfig.HttpClient.Transport = helper.NewTransport(
@@ -244,72 +348,72 @@ func (p *ProviderMeta) setClient() error {
 	client.SetCloneToken(true)
 
 	// Set headers if provided
-	// Get the ok value to avoid panics but don't need to check it explicitly
-	// as we handle nil headers gracefully below.
-	headers, _ := d.Get("headers").([]interface{})
-	parsedHeaders := client.Headers().Clone()
-
-	if parsedHeaders == nil {
-		parsedHeaders = make(http.Header)
-	}
-
-	for _, h := range headers {
-		header := h.(map[string]interface{})
-		if name, ok := header["name"]; ok {
-			parsedHeaders.Add(name.(string), header["value"].(string))
-		}
+	parsedHeaders, err := parseHeaders(d, client.Headers())
+	if err != nil {
+		return err
 	}
 	client.SetHeaders(parsedHeaders)
 
 	client.SetMaxRetries(GetResourceDataInt(d, "max_retries", "VAULT_MAX_RETRIES", DefaultMaxHTTPRetries))
 
+	if err := configureRetryWait(client,
+		GetResourceDataStr(d, consts.FieldMinRetryWait, "", ""),
+		GetResourceDataStr(d, consts.FieldMaxRetryWait, "", ""),
+	); err != nil {
+		return err
+	}
+
+	if err := configureRequestTimeout(client,
+		GetResourceDataStr(d, consts.FieldRequestTimeout, "", ""),
+	); err != nil {
+		return err
+	}
+
 	MaxHTTPRetriesCCC = GetResourceDataInt(d, "max_retries_ccc", "VAULT_MAX_RETRIES_CCC", DefaultMaxHTTPRetriesCCC)
 
 	// Set the namespace to the requested namespace, if provided
 	namespace := GetResourceDataStr(d, consts.FieldNamespace, "VAULT_NAMESPACE", "")
 
-	authLogin, err := GetAuthLogin(d)
+	authFields, err := GetAuthLoginFields(d)
 	if err != nil {
 		return err
 	}
 
 	var token string
-	if authLogin != nil {
-		// the clone is only used to auth to Vault
-		clone, err := client.Clone()
-		if err != nil {
-			return err
-		}
-
-		if clone.Token() != "" {
-			log.Printf("[WARN] A vault token was set from the runtime environment, "+
-				"clearing it for auth_login method %q", authLogin.Method())
-			clone.ClearToken()
-		}
+	if len(authFields) > 0 {
+		// try each auth login method in order of priority, until one succeeds.
+		var errs error
+		for _, authField := range authFields {
+			token, err = getAuthLoginToken(d, client, authField, namespace)
+			if err == nil {
+				break
+			}
 
-		if ns, ok := authLogin.Namespace(); ok {
-			// the namespace configured on the auth_login takes precedence over the provider's
-			// for authentication only.
-			log.Printf("[DEBUG] Setting Auth Login namespace to %q, use_root_namespace=%t", ns, ns == "")
-			clone.SetNamespace(ns)
-		} else if namespace != "" {
-			// authenticate to the engine in the provider's namespace
-			log.Printf("[DEBUG] Setting Auth Login namespace to %q from provider configuration", namespace)
-			clone.SetNamespace(namespace)
+			if len(authFields) > 1 {
+				log.Printf("[WARN] Failed to authenticate with %q, err=%s", authField, err)
+			}
+			errs = errors.Join(errs, err)
 		}
 
-		secret, err := authLogin.Login(clone)
 		if err != nil {
-			return err
+			return errs
 		}
-
-		token = secret.Auth.ClientToken
 	} else {
 		// try and get the token from the config or token helper
 		token, err = GetToken(d)
 		if err != nil {
 			return err
 		}
+
+		// fall back to the Vault Agent's auto-auth token sink file, if one is set
+		if token == "" && agentAddr {
+			if filename := os.Getenv(consts.EnvVarTokenFilename); filename != "" {
+				token, err = readTokenFile(filename)
+				if err != nil {
+					return fmt.Errorf("failed to read the Vault Agent token sink file: %w", err)
+				}
+			}
+		}
 	}
 
 	if token != "" {
@@ -317,7 +421,13 @@ func (p *ProviderMeta) setClient() error {
 	}
 
 	if client.Token() == "" {
-		return errors.New("no vault token set on Client")
+		if !agentAddr {
+			return errors.New("no vault token set on Client, " +
+				"one must be provided by the token argument, an auth_login block, " +
+				"the VAULT_TOKEN environment variable, ~/.vault-token, or a configured token helper")
+		}
+		log.Printf("[DEBUG] No vault token set on Client, "+
+			"relying on the Vault Agent or Vault Proxy at %q to authenticate requests", addr)
 	}
 
 	tokenInfo, err := client.Auth().Token().LookupSelf()
@@ -346,25 +456,16 @@ func (p *ProviderMeta) setClient() error {
 		client.SetToken(token)
 	}
 
-	if namespace == "" && tokenNamespace != "" {
+	setNamespaceFromToken := GetResourceDataBool(d, consts.FieldSetNamespaceFromToken,
+		consts.EnvVarSetNamespaceFromToken, true)
+	if namespace == "" && tokenNamespace != "" && setNamespaceFromToken {
 		// set the provider namespace to the token's namespace
 		// this is here to ensure that do not break any configurations that are relying on the
 		// token's namespace being used during resource provisioning.
-		// In the future we should drop support for this behaviour.
-		log.Printf("[WARN] The provider namespace should be set whenever "+
-			"using namespaced auth tokens. You may want to update your provider "+
-			"configuration's namespace to be %q, before executing terraform. "+
-			"Future releases may not support this type of configuration.", tokenNamespace)
+		log.Printf("[DEBUG] Setting provider namespace to %q from the token's namespace, "+
+			"set %q to false to disable", tokenNamespace, consts.FieldSetNamespaceFromToken)
 
 		namespace = tokenNamespace
-		// set the namespace on the provider to ensure that all child
-		// namespace paths are properly honoured.
-		setTokenFromNamespace := GetResourceDataBool(d, consts.FieldSetNamespaceFromToken, "VAULT_SET_NAMESPACE_FROM_TOKEN", true)
-		if setTokenFromNamespace {
-			if err := d.Set(consts.FieldNamespace, namespace); err != nil {
-				return err
-			}
-		}
 	}
 
 	if namespace != "" {
@@ -384,13 +485,186 @@ func (p *ProviderMeta) setClient() error {
 	return nil
 }
 
+// getAuthLoginToken authenticates to Vault with the auth login method
+// configured for authField and returns the resulting token. An authField of
+// "token" returns the token from the provider configuration, environment or
+// token helper.
+func getAuthLoginToken(d *schema.ResourceData, client *api.Client, authField, namespace string) (string, error) {
+	if authField == consts.FieldToken {
+		token, err := GetToken(d)
+		if err != nil {
+			return "", err
+		}
+
+		if token == "" {
+			return "", errors.New("no vault token found in the provider configuration, " +
+				"the VAULT_TOKEN environment variable, ~/.vault-token, or a configured token helper")
+		}
+
+		return token, nil
+	}
+
+	entry, err := globalAuthLoginRegistry.Get(authField)
+	if err != nil {
+		return "", err
+	}
+
+	authLogin, err := entry.AuthLogin(d)
+	if err != nil {
+		return "", err
+	}
+
+	// the clone is only used to auth to Vault
+	clone, err := client.Clone()
+	if err != nil {
+		return "", err
+	}
+
+	if clone.Token() != "" {
+		log.Printf("[WARN] A vault token was set from the runtime environment, "+
+			"clearing it for auth_login method %q", authLogin.Method())
+		clone.ClearToken()
+	}
+
+	if ns, ok := authLogin.Namespace(); ok {
+		// the namespace configured on the auth_login takes precedence over the provider's
+		// for authentication only.
+		log.Printf("[DEBUG] Setting Auth Login namespace to %q, use_root_namespace=%t", ns, ns == "")
+		clone.SetNamespace(ns)
+	} else if namespace != "" {
+		// authenticate to the engine in the provider's namespace
+		log.Printf("[DEBUG] Setting Auth Login namespace to %q from provider configuration", namespace)
+		clone.SetNamespace(namespace)
+	}
+
+	secret, err := authLogin.Login(clone)
+	if err != nil {
+		return "", err
+	}
+
+	return secret.Auth.ClientToken, nil
+}
+
+// configureUnixSocket sets up the api.Config to connect to a unix domain
+// socket, e.g. the listener of a local Vault Agent or Vault Proxy, when its
+// address has the unix:// scheme.
+func configureUnixSocket(config *api.Config) error {
+	if !strings.HasPrefix(config.Address, unixSocketScheme) {
+		return nil
+	}
+
+	socket := strings.TrimPrefix(config.Address, unixSocketScheme)
+	if socket == "" {
+		return fmt.Errorf("no socket path found in address %q", config.Address)
+	}
+
+	transport, ok := config.HttpClient.Transport.(*http.Transport)
+	if !ok {
+		return fmt.Errorf("unable to configure unix socket %q, unexpected transport %T",
+			socket, config.HttpClient.Transport)
+	}
+
+	transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+		var dialer net.Dialer
+		return dialer.DialContext(ctx, "unix", socket)
+	}
+	// connections never leave the host, so no proxy must be used.
+	transport.Proxy = nil
+
+	// the scheme and host are only used to build the request URL,
+	// all connections are made over the unix socket.
+	config.Address = "http://localhost"
+
+	return nil
+}
+
+// configureProxy sets up the api.Config to connect to Vault through the
+// proxy at proxyURL. Any proxy configured from the environment is overridden.
+func configureProxy(config *api.Config, proxyURL string) error {
+	if proxyURL == "" {
+		return nil
+	}
+
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return fmt.Errorf("failed to parse %s %q: %w", consts.FieldProxyURL, proxyURL, err)
+	}
+
+	transport, ok := config.HttpClient.Transport.(*http.Transport)
+	if !ok {
+		return fmt.Errorf("unable to configure proxy %q, unexpected transport %T",
+			proxyURL, config.HttpClient.Transport)
+	}
+
+	transport.Proxy = http.ProxyURL(u)
+
+	return nil
+}
+
+// configureRetryWait sets the min and max retry wait of the client. When
+// either is configured, retries back off exponentially between them, otherwise
+// they back off linearly. In both cases any Retry-After header returned by
+// Vault is honored.
+func configureRetryWait(client *api.Client, minRetryWait, maxRetryWait string) error {
+	if minRetryWait == "" && maxRetryWait == "" {
+		client.SetBackoff(retryablehttp.RateLimitLinearJitterBackoff)
+		return nil
+	}
+
+	if minRetryWait != "" {
+		wait, err := time.ParseDuration(minRetryWait)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s %q: %w", consts.FieldMinRetryWait, minRetryWait, err)
+		}
+		client.SetMinRetryWait(wait)
+	}
+
+	if maxRetryWait != "" {
+		wait, err := time.ParseDuration(maxRetryWait)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s %q: %w", consts.FieldMaxRetryWait, maxRetryWait, err)
+		}
+		client.SetMaxRetryWait(wait)
+	}
+
+	if client.MinRetryWait() > client.MaxRetryWait() {
+		if maxRetryWait != "" {
+			return fmt.Errorf("%q must not be greater than %q",
+				consts.FieldMinRetryWait, consts.FieldMaxRetryWait)
+		}
+		// only the min retry wait was configured, raise the default max
+		// retry wait to match it.
+		client.SetMaxRetryWait(client.MinRetryWait())
+	}
+
+	client.SetBackoff(retryablehttp.DefaultBackoff)
+
+	return nil
+}
+
+// configureRequestTimeout sets the timeout of each request made by the client.
+// When unset, the client's default timeout is kept, which may already have
+// been set from VAULT_CLIENT_TIMEOUT.
+func configureRequestTimeout(client *api.Client, requestTimeout string) error {
+	if requestTimeout == "" {
+		return nil
+	}
+
+	timeout, err := time.ParseDuration(requestTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to parse %s %q: %w", consts.FieldRequestTimeout, requestTimeout, err)
+	}
+	client.SetClientTimeout(timeout)
+
+	return nil
+}
+
 func (p *ProviderMeta) setVaultVersion() error {
 	if p.vaultVersion != nil {
 		return nil
 	}
 
 	d := p.resourceData
-	skipGetVaultVersion := GetResourceDataBool(d, consts.FieldSkipGetVaultVersion, "", false)
 	var vaultVersion *version.Version
 	if v, ok := d.GetOk(consts.FieldVaultVersionOverride); ok {
 		ver, err := version.NewVersion(v.(string))
@@ -399,7 +673,7 @@ func (p *ProviderMeta) setVaultVersion() error {
 				consts.FieldVaultVersionOverride, err)
 		}
 		vaultVersion = ver
-	} else if !skipGetVaultVersion {
+	} else if !p.skipGetVaultVersion() {
 		// Set the Vault version to *ProviderMeta object
 		client, err := p.getClient()
 		if err != nil {
@@ -439,6 +713,129 @@ func NewProviderMeta(d *schema.ResourceData) (interface{}, error) {
 	}, nil
 }
 
+// ConfigureProvider validates the provider configuration, then sets up the
+// Provider to service Vault requests.
+func ConfigureProvider(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	if !GetResourceDataBool(d, consts.FieldSkipValidation, consts.EnvVarSkipValidation, false) {
+		if diags := validateProviderConfig(d); diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	meta, err := NewProviderMeta(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	if GetResourceDataBool(d, consts.FieldCheckConnectivity, consts.EnvVarCheckConnectivity, false) {
+		if err := meta.(*ProviderMeta).checkConnectivity(); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
+	return meta, nil
+}
+
+// checkConnectivity sets up the Vault client, ensures that the Vault server
+// is initialized and unsealed, and caches its version.
+func (p *ProviderMeta) checkConnectivity() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	client, err := p.getClient()
+	if err != nil {
+		return err
+	}
+
+	clone, err := client.Clone()
+	if err != nil {
+		return err
+	}
+
+	clone.ClearNamespace()
+	health, err := clone.Sys().Health()
+	if err != nil {
+		return fmt.Errorf("failed to check the health of Vault at %q, err=%w", client.Address(), err)
+	}
+
+	if !health.Initialized {
+		return fmt.Errorf("vault at %q is not initialized", client.Address())
+	}
+
+	if health.Sealed {
+		return fmt.Errorf("vault at %q is sealed", client.Address())
+	}
+
+	return p.setVaultVersion()
+}
+
+// validateProviderConfig validates the parts of the provider configuration
+// that can be checked without making any requests to Vault.
+func validateProviderConfig(d *schema.ResourceData) diag.Diagnostics {
+	// the address may depend on values that are only known during apply,
+	// in which case it is validated when the Vault client is set up.
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() && !rawConfig.GetAttr(consts.FieldAddress).IsKnown() {
+		return nil
+	}
+
+	addrPath := cty.GetAttrPath(consts.FieldAddress)
+	addr, _ := getVaultAddress(d)
+	if addr == "" {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  "Missing Vault address",
+				Detail: fmt.Sprintf("The Vault address must be set with the %q argument, "+
+					"or the %s or %s environment variables.",
+					consts.FieldAddress, api.EnvVaultAddress, api.EnvVaultAgentAddr),
+				AttributePath: addrPath,
+			},
+		}
+	}
+
+	if strings.HasPrefix(addr, unixSocketScheme) {
+		if addr == unixSocketScheme {
+			return diag.Diagnostics{
+				{
+					Severity:      diag.Error,
+					Summary:       "Invalid Vault address",
+					Detail:        fmt.Sprintf("No socket path found in address %q.", addr),
+					AttributePath: addrPath,
+				},
+			}
+		}
+		return nil
+	}
+
+	if u, err := url.Parse(addr); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  "Invalid Vault address",
+				Detail: fmt.Sprintf("The Vault address %q must be a URL with an http or https scheme "+
+					"and a host, e.g. https://vault.example.com:8200, or a unix:// socket path.", addr),
+				AttributePath: addrPath,
+			},
+		}
+	}
+
+	return nil
+}
+
+// getVaultAddress returns the Vault address from the provider configuration
+// or the environment, and whether it is the address of a local Vault Agent or
+// Vault Proxy.
+func getVaultAddress(d *schema.ResourceData) (string, bool) {
+	addr := GetResourceDataStr(d, consts.FieldAddress, api.EnvVaultAddress, "")
+	agentAddr := strings.HasPrefix(addr, unixSocketScheme)
+	if addr == "" {
+		addr = os.Getenv(api.EnvVaultAgentAddr)
+		agentAddr = addr != ""
+	}
+
+	return addr, agentAddr
+}
+
 func warnMinTokenTTL(tokenInfo *api.Secret) {
 	// tokens with "root" policies tend to have no TTL set, so there should be no
 	// need to warn in this case.
@@ -557,6 +954,133 @@ func IsEnterpriseSupported(meta interface{}) bool {
 	return p.IsEnterpriseSupported()
 }
 
+// CheckEnterpriseSupported returns an error when the Vault server is not Vault
+// Enterprise, or when its version cannot be determined. No error is returned
+// when the Vault server version is overridden, or skip_get_vault_version is
+// set.
+func CheckEnterpriseSupported(meta interface{}, name string) error {
+	var p *ProviderMeta
+	switch v := meta.(type) {
+	case *ProviderMeta:
+		p = v
+	default:
+		panic(fmt.Sprintf("meta argument must be a %T, not %T", p, meta))
+	}
+
+	if p.resourceData != nil {
+		if _, ok := p.resourceData.GetOk(consts.FieldVaultVersionOverride); ok {
+			return nil
+		}
+	}
+
+	currentVersion := p.GetVaultVersion()
+	if currentVersion == nil {
+		if p.skipGetVaultVersion() {
+			return nil
+		}
+		return fmt.Errorf("%s requires Vault Enterprise, but the Vault server version "+
+			"could not be determined; consider setting %q", name, consts.FieldVaultVersionOverride)
+	}
+
+	if !strings.Contains(currentVersion.Metadata(), enterpriseMetadata) {
+		return fmt.Errorf("%s requires Vault Enterprise, current vault version=%s", name, currentVersion)
+	}
+
+	return nil
+}
+
+// CheckNamespacesSupported returns an error when the Vault server does not
+// support namespaces.
+func CheckNamespacesSupported(meta interface{}, name string) error {
+	return checkCapabilitySupported(meta, name, CapabilityNamespaces)
+}
+
+// CheckSentinelSupported returns an error when the Vault server does not
+// support Sentinel policies.
+func CheckSentinelSupported(meta interface{}, name string) error {
+	return checkCapabilitySupported(meta, name, CapabilitySentinel)
+}
+
+// CheckPerformanceReplicationSupported returns an error when the Vault server
+// does not support performance replication.
+func CheckPerformanceReplicationSupported(meta interface{}, name string) error {
+	return checkCapabilitySupported(meta, name, CapabilityPerformanceReplication)
+}
+
+// CheckKMIPSupported returns an error when the Vault server does not support
+// the KMIP secrets engine.
+func CheckKMIPSupported(meta interface{}, name string) error {
+	return checkCapabilitySupported(meta, name, CapabilityKMIP)
+}
+
+// CheckTransformSupported returns an error when the Vault server does not
+// support the Transform secrets engine.
+func CheckTransformSupported(meta interface{}, name string) error {
+	return checkCapabilitySupported(meta, name, CapabilityTransform)
+}
+
+// checkCapabilitySupported returns an error when the Vault server is not Vault
+// Enterprise, or when its license does not include the capability. Only the
+// edition is checked when the license could not be read.
+func checkCapabilitySupported(meta interface{}, name string, capability Capability) error {
+	if err := CheckEnterpriseSupported(meta, name); err != nil {
+		return err
+	}
+
+	p := meta.(*ProviderMeta)
+	if p.resourceData != nil {
+		if _, ok := p.resourceData.GetOk(consts.FieldVaultVersionOverride); ok {
+			return nil
+		}
+	}
+
+	capabilities := p.GetCapabilities()
+	if capabilities == nil {
+		return nil
+	}
+
+	if !capabilities[capability] {
+		return fmt.Errorf("%s requires the Vault Enterprise %q feature, "+
+			"which is not included in the Vault server's license", name, capability)
+	}
+
+	return nil
+}
+
+// CheckFeatureSupported returns an error when the Vault server does not
+// support a feature requiring minVersion, and Vault Enterprise if enterprise
+// is true, or when its version cannot be determined. No error is returned
+// when skip_get_vault_version is set.
+func CheckFeatureSupported(meta interface{}, minVersion *version.Version, enterprise bool) error {
+	var p *ProviderMeta
+	switch v := meta.(type) {
+	case *ProviderMeta:
+		p = v
+	default:
+		panic(fmt.Sprintf("meta argument must be a %T, not %T", p, meta))
+	}
+
+	currentVersion := p.GetVaultVersion()
+	if currentVersion == nil {
+		if p.skipGetVaultVersion() {
+			return nil
+		}
+		return fmt.Errorf("feature requires Vault %s or later, but the Vault server version "+
+			"could not be determined; consider setting %q", minVersion, consts.FieldVaultVersionOverride)
+	}
+
+	if currentVersion.LessThan(minVersion) {
+		return fmt.Errorf("feature not enabled on current Vault version. min version required=%s; "+
+			"current vault version=%s", minVersion, currentVersion)
+	}
+
+	if enterprise && !p.IsEnterpriseSupported() {
+		return errors.New("feature requires Vault Enterprise")
+	}
+
+	return nil
+}
+
 func getVaultVersion(client *api.Client) (*version.Version, error) {
 	clone, err := client.Clone()
 	if err != nil {
@@ -577,7 +1101,12 @@ func getVaultVersion(client *api.Client) (*version.Version, error) {
 		return nil, fmt.Errorf("key %q not found in response", consts.FieldVersion)
 	}
 
-	return version.Must(version.NewSemver(resp.Version)), nil
+	ver, err := version.NewSemver(resp.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Vault server version %q, err=%w", resp.Version, err)
+	}
+
+	return ver, nil
 }
 
 func createChildToken(d *schema.ResourceData, c *api.Client, namespace string) (string, error) {
@@ -621,9 +1150,55 @@ func createChildToken(d *schema.ResourceData, c *api.Client, namespace string) (
 
 	log.Printf("[INFO] Using Vault token with the following policies: %s", strings.Join(policies, ", "))
 
+	if GetResourceDataBool(d, consts.FieldRevokeChildToken, consts.EnvVarRevokeChildToken, false) {
+		// keep track of the child token so that it can be revoked once the
+		// provider is shut down.
+		clone.SetToken(childToken)
+		childTokens.add(clone)
+	}
+
 	return childToken, nil
 }
 
+// childTokens holds the clients of all child tokens created by the provider.
+var childTokens = &childTokenRegistry{}
+
+type childTokenRegistry struct {
+	m       sync.Mutex
+	clients []*api.Client
+}
+
+func (r *childTokenRegistry) add(c *api.Client) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.clients = append(r.clients, c)
+}
+
+// revoke revokes all registered child tokens, along with any leases
+// that were issued to them. The registry is emptied regardless of the
+// outcome.
+func (r *childTokenRegistry) revoke(ctx context.Context) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	var errs error
+	for _, c := range r.clients {
+		if err := c.Auth().Token().RevokeSelfWithContext(ctx, ""); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to revoke child token: %w", err))
+		}
+	}
+	r.clients = nil
+
+	return errs
+}
+
+// RevokeChildTokens revokes all child tokens created by the provider
+// during its lifetime. It should be called once the provider server has
+// shut down.
+func RevokeChildTokens(ctx context.Context) error {
+	return childTokens.revoke(ctx)
+}
+
 // GetResourceDataStr returns the value for a given ResourceData field
 // If the value is the zero value, then it checks the environment variable. If
 // the environment variable is empty, the default dv is returned
@@ -710,6 +1285,53 @@ func GetResourceDataBool(d *schema.ResourceData, field, env string, dv bool) boo
 	return dv
 }
 
+// parseHeaders returns a copy of base with the provider's configured headers added.
+// The token and namespace headers are managed by the provider, so they cannot be configured.
+func parseHeaders(d *schema.ResourceData, base http.Header) (http.Header, error) {
+	parsedHeaders := base.Clone()
+	if parsedHeaders == nil {
+		parsedHeaders = make(http.Header)
+	}
+
+	// Get the ok value to avoid panics but don't need to check it explicitly
+	// as we handle nil headers gracefully below.
+	headers, _ := d.Get("headers").([]interface{})
+	for _, h := range headers {
+		header := h.(map[string]interface{})
+		name, ok := header["name"].(string)
+		if !ok {
+			continue
+		}
+
+		switch http.CanonicalHeaderKey(name) {
+		case http.CanonicalHeaderKey(api.AuthHeaderName),
+			http.CanonicalHeaderKey(api.NamespaceHeaderName):
+			return nil, fmt.Errorf("header %q cannot be set in the provider's headers, "+
+				"it is managed by the provider", name)
+		}
+
+		parsedHeaders.Add(name, header["value"].(string))
+	}
+
+	return parsedHeaders, nil
+}
+
+// getTLSConfig returns the api.TLSConfig for the provider's Vault client.
+// Unset fields fall back to their corresponding VAULT_* environment variables.
+func getTLSConfig(d *schema.ResourceData) *api.TLSConfig {
+	// the client_auth block's fields are required, so the client certificate
+	// can only be had from the environment when the block is not configured.
+	prefix := fmt.Sprintf("%s.0.", consts.FieldClientAuth)
+	return &api.TLSConfig{
+		CACert:        GetResourceDataStr(d, consts.FieldCACertFile, api.EnvVaultCACert, ""),
+		CAPath:        GetResourceDataStr(d, consts.FieldCACertDir, api.EnvVaultCAPath, ""),
+		ClientCert:    GetResourceDataStr(d, prefix+consts.FieldCertFile, api.EnvVaultClientCert, ""),
+		ClientKey:     GetResourceDataStr(d, prefix+consts.FieldKeyFile, api.EnvVaultClientKey, ""),
+		Insecure:      GetResourceDataBool(d, consts.FieldSkipTLSVerify, "VAULT_SKIP_VERIFY", false),
+		TLSServerName: GetResourceDataStr(d, consts.FieldTLSServerName, api.EnvVaultTLSServerName, ""),
+	}
+}
+
 func GetToken(d *schema.ResourceData) (string, error) {
 	token, ok := d.Get("token").(string)
 	if !ok {
@@ -741,7 +1363,7 @@ func GetToken(d *schema.ResourceData) (string, error) {
 	}
 
 	// Use ~/.vault-token, or the configured token helper.
-	tokenHelper, err := config.DefaultTokenHelper()
+	tokenHelper, err := getTokenHelper()
 	if err != nil {
 		return "", fmt.Errorf("error getting token helper: %s", err)
 	}
@@ -752,6 +1374,21 @@ func GetToken(d *schema.ResourceData) (string, error) {
 	return strings.TrimSpace(token), nil
 }
 
+// getTokenHelper returns the external token helper set by VAULT_TOKEN_HELPER,
+// otherwise the token helper of the Vault CLI configuration, which defaults to
+// reading ~/.vault-token.
+func getTokenHelper() (tokenhelper.TokenHelper, error) {
+	if v := os.Getenv(consts.EnvVarVaultTokenHelper); v != "" {
+		path, err := tokenhelper.ExternalTokenHelperPath(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", consts.EnvVarVaultTokenHelper, v, err)
+		}
+		return &tokenhelper.ExternalTokenHelper{BinaryPath: path}, nil
+	}
+
+	return config.DefaultTokenHelper()
+}
+
 func getHCLogger() hclog.Logger {
 	logger := hclog.Default()
 	if logging.IsDebugOrHigher() {
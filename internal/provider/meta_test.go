@@ -4,20 +4,27 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"reflect"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/vault/api"
 	vault_consts "github.com/hashicorp/vault/sdk/helper/consts"
+	"github.com/mitchellh/go-homedir"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/testutil"
@@ -97,6 +104,23 @@ func TestProviderMeta_GetNSClient(t *testing.T) {
 			expectNs: "bar/foo",
 			calls:    5,
 		},
+		{
+			name:   "nested-root-ns-trimmed",
+			client: rootClient,
+			resourceData: schema.TestResourceDataRaw(t,
+				map[string]*schema.Schema{
+					"namespace": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+				map[string]interface{}{
+					"namespace": "/bar/baz/",
+				},
+			),
+			ns:       "foo/qux",
+			expectNs: "bar/baz/foo/qux",
+		},
 	}
 
 	assertClientCache := func(t *testing.T, p *ProviderMeta, expectedCache map[string]*api.Client) {
@@ -818,3 +842,1330 @@ func TestGetResourceDataInt(t *testing.T) {
 		})
 	}
 }
+
+func TestParseHeaders(t *testing.T) {
+	tests := []struct {
+		name      string
+		raw       map[string]interface{}
+		base      http.Header
+		want      http.Header
+		expectErr error
+	}{
+		{
+			name: "basic",
+			raw: map[string]interface{}{
+				"headers": []interface{}{
+					map[string]interface{}{
+						"name":  "X-Forwarded-For",
+						"value": "10.0.0.1",
+					},
+					map[string]interface{}{
+						"name":  "x-tenant",
+						"value": "foo",
+					},
+				},
+			},
+			base: http.Header{
+				"X-Vault-Request": []string{"true"},
+			},
+			want: http.Header{
+				"X-Vault-Request": []string{"true"},
+				"X-Forwarded-For": []string{"10.0.0.1"},
+				"X-Tenant":        []string{"foo"},
+			},
+		},
+		{
+			name: "unset",
+			raw:  map[string]interface{}{},
+			want: http.Header{},
+		},
+		{
+			name: "error-token-header",
+			raw: map[string]interface{}{
+				"headers": []interface{}{
+					map[string]interface{}{
+						"name":  "x-vault-token",
+						"value": "foo",
+					},
+				},
+			},
+			expectErr: fmt.Errorf("header %q cannot be set in the provider's headers, "+
+				"it is managed by the provider", "x-vault-token"),
+		},
+		{
+			name: "error-namespace-header",
+			raw: map[string]interface{}{
+				"headers": []interface{}{
+					map[string]interface{}{
+						"name":  api.NamespaceHeaderName,
+						"value": "ns1",
+					},
+				},
+			},
+			expectErr: fmt.Errorf("header %q cannot be set in the provider's headers, "+
+				"it is managed by the provider", api.NamespaceHeaderName),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, NewProvider(nil, nil).Schema, tt.raw)
+			got, err := parseHeaders(d, tt.base)
+			if !reflect.DeepEqual(err, tt.expectErr) {
+				t.Fatalf("parseHeaders() error = %v, expectErr %v", err, tt.expectErr)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseHeaders() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestGetTLSConfig tests the getTLSConfig function.
+// Its subtests should not be run in parallel because it mutates the test runner's environment.
+func TestGetTLSConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  map[string]interface{}
+		env  map[string]string
+		want *api.TLSConfig
+	}{
+		{
+			name: "from-config",
+			raw: map[string]interface{}{
+				consts.FieldCACertFile:    "/ca.pem",
+				consts.FieldCACertDir:     "/ca",
+				consts.FieldTLSServerName: "vault.example.com",
+				consts.FieldClientAuth: []interface{}{
+					map[string]interface{}{
+						consts.FieldCertFile: "/cert.pem",
+						consts.FieldKeyFile:  "/key.pem",
+					},
+				},
+			},
+			env: map[string]string{
+				api.EnvVaultCACert:        "/env-ca.pem",
+				api.EnvVaultClientCert:    "/env-cert.pem",
+				api.EnvVaultClientKey:     "/env-key.pem",
+				api.EnvVaultTLSServerName: "env.example.com",
+			},
+			want: &api.TLSConfig{
+				CACert:        "/ca.pem",
+				CAPath:        "/ca",
+				ClientCert:    "/cert.pem",
+				ClientKey:     "/key.pem",
+				TLSServerName: "vault.example.com",
+			},
+		},
+		{
+			name: "from-env",
+			raw:  map[string]interface{}{},
+			env: map[string]string{
+				api.EnvVaultCACert:        "/env-ca.pem",
+				api.EnvVaultCAPath:        "/env-ca",
+				api.EnvVaultClientCert:    "/env-cert.pem",
+				api.EnvVaultClientKey:     "/env-key.pem",
+				api.EnvVaultTLSServerName: "env.example.com",
+			},
+			want: &api.TLSConfig{
+				CACert:        "/env-ca.pem",
+				CAPath:        "/env-ca",
+				ClientCert:    "/env-cert.pem",
+				ClientKey:     "/env-key.pem",
+				TLSServerName: "env.example.com",
+			},
+		},
+		{
+			name: "unset",
+			raw:  map[string]interface{}{},
+			want: &api.TLSConfig{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, k := range []string{
+				api.EnvVaultCACert,
+				api.EnvVaultCAPath,
+				api.EnvVaultClientCert,
+				api.EnvVaultClientKey,
+				api.EnvVaultTLSServerName,
+			} {
+				t.Setenv(k, tt.env[k])
+			}
+
+			d := schema.TestResourceDataRaw(t, NewProvider(nil, nil).Schema, tt.raw)
+			if got := getTLSConfig(d); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getTLSConfig() got = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigureUnixSocket(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "agent.sock")
+	ln, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data":{"foo":"bar"}}`))
+		}),
+	}
+	go server.Serve(ln)
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		address  string
+		wantAddr string
+		wantErr  bool
+	}{
+		{
+			name:     "unix-socket",
+			address:  "unix://" + socket,
+			wantAddr: "http://localhost",
+		},
+		{
+			name:     "tcp",
+			address:  "http://127.0.0.1:8200",
+			wantAddr: "http://127.0.0.1:8200",
+		},
+		{
+			name:    "no-socket-path",
+			address: "unix://",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := api.DefaultConfig()
+			config.Address = tt.address
+
+			err := configureUnixSocket(config)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("configureUnixSocket() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if config.Address != tt.wantAddr {
+				t.Fatalf("configureUnixSocket() address = %q, want %q", config.Address, tt.wantAddr)
+			}
+
+			if tt.address != "unix://"+socket {
+				return
+			}
+
+			client, err := api.NewClient(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := client.Logical().Read("secret/foo")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(resp.Data, map[string]interface{}{"foo": "bar"}) {
+				t.Fatalf("unexpected response data %#v", resp.Data)
+			}
+		})
+	}
+}
+
+func TestChildTokenRegistry_revoke(t *testing.T) {
+	var m sync.Mutex
+	var revoked []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/v1/auth/token/revoke-self" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		m.Lock()
+		defer m.Unlock()
+		revoked = append(revoked, req.Header.Get(api.AuthHeaderName))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	r := &childTokenRegistry{}
+	for _, token := range []string{"child-1", "child-2"} {
+		config := api.DefaultConfig()
+		config.Address = ts.URL
+		c, err := api.NewClient(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.SetToken(token)
+		r.add(c)
+	}
+
+	if err := r.revoke(context.Background()); err != nil {
+		t.Fatalf("revoke() unexpected error %s", err)
+	}
+
+	if want := []string{"child-1", "child-2"}; !reflect.DeepEqual(want, revoked) {
+		t.Fatalf("revoke() expected revoked tokens %#v, actual %#v", want, revoked)
+	}
+
+	if len(r.clients) != 0 {
+		t.Fatalf("revoke() expected empty registry, actual %d clients", len(r.clients))
+	}
+}
+
+func TestConfigureProxy(t *testing.T) {
+	tests := []struct {
+		name      string
+		proxyURL  string
+		wantProxy string
+		wantErr   bool
+	}{
+		{
+			name:     "unset",
+			proxyURL: "",
+		},
+		{
+			name:      "http",
+			proxyURL:  "http://proxy.example.com:3128",
+			wantProxy: "http://proxy.example.com:3128",
+		},
+		{
+			name:      "https",
+			proxyURL:  "https://proxy.example.com",
+			wantProxy: "https://proxy.example.com",
+		},
+		{
+			name:     "invalid",
+			proxyURL: "http://proxy.example.com:%zz",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := api.DefaultConfig()
+			// ignore any proxy configured from the environment
+			config.HttpClient.Transport.(*http.Transport).Proxy = nil
+
+			err := configureProxy(config, tt.proxyURL)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("configureProxy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			proxy := config.HttpClient.Transport.(*http.Transport).Proxy
+			if tt.wantProxy == "" {
+				if proxy != nil {
+					t.Fatalf("configureProxy() expected no proxy to be set")
+				}
+				return
+			}
+
+			req, err := http.NewRequest(http.MethodGet, "https://vault.example.com:8200", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			u, err := proxy(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if u.String() != tt.wantProxy {
+				t.Fatalf("configureProxy() proxy = %q, want %q", u.String(), tt.wantProxy)
+			}
+		})
+	}
+}
+
+func TestConfigureRetryWait(t *testing.T) {
+	tests := []struct {
+		name         string
+		minRetryWait string
+		maxRetryWait string
+		wantMin      time.Duration
+		wantMax      time.Duration
+		wantErr      bool
+	}{
+		{
+			name:    "unset",
+			wantMin: time.Second,
+			wantMax: 1500 * time.Millisecond,
+		},
+		{
+			name:         "min-only",
+			minRetryWait: "500ms",
+			wantMin:      500 * time.Millisecond,
+			wantMax:      1500 * time.Millisecond,
+		},
+		{
+			name:         "max-only",
+			maxRetryWait: "10s",
+			wantMin:      time.Second,
+			wantMax:      10 * time.Second,
+		},
+		{
+			name:         "both",
+			minRetryWait: "2s",
+			maxRetryWait: "1m",
+			wantMin:      2 * time.Second,
+			wantMax:      time.Minute,
+		},
+		{
+			name:         "min-above-default-max",
+			minRetryWait: "5s",
+			wantMin:      5 * time.Second,
+			wantMax:      5 * time.Second,
+		},
+		{
+			name:         "min-above-max",
+			minRetryWait: "10s",
+			maxRetryWait: "5s",
+			wantErr:      true,
+		},
+		{
+			name:         "invalid-min",
+			minRetryWait: "10",
+			wantErr:      true,
+		},
+		{
+			name:         "invalid-max",
+			maxRetryWait: "foo",
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := api.NewClient(api.DefaultConfig())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = configureRetryWait(client, tt.minRetryWait, tt.maxRetryWait)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("configureRetryWait() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if got := client.MinRetryWait(); got != tt.wantMin {
+				t.Errorf("configureRetryWait() min retry wait = %s, want %s", got, tt.wantMin)
+			}
+			if got := client.MaxRetryWait(); got != tt.wantMax {
+				t.Errorf("configureRetryWait() max retry wait = %s, want %s", got, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestConfigureRetryWait_retryAfter(t *testing.T) {
+	tests := []struct {
+		name         string
+		minRetryWait string
+		maxRetryWait string
+		retryAfter   time.Duration
+	}{
+		{
+			// the default retry wait is at most 1.5s
+			name:       "unset",
+			retryAfter: 2 * time.Second,
+		},
+		{
+			name:         "configured",
+			minRetryWait: "10ms",
+			maxRetryWait: "20ms",
+			retryAfter:   time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+			config, ln := testutil.TestHTTPServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if requests == 1 {
+					w.Header().Set("Retry-After", fmt.Sprintf("%d", int(tt.retryAfter.Seconds())))
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"data": map[string]interface{}{},
+				})
+			}))
+			t.Cleanup(func() { ln.Close() })
+
+			client, err := api.NewClient(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := configureRetryWait(client, tt.minRetryWait, tt.maxRetryWait); err != nil {
+				t.Fatal(err)
+			}
+
+			start := time.Now()
+			if _, err := client.Logical().Read("secret/foo"); err != nil {
+				t.Fatal(err)
+			}
+
+			if requests != 2 {
+				t.Fatalf("expected 2 requests, got %d", requests)
+			}
+			if elapsed := time.Since(start); elapsed < tt.retryAfter {
+				t.Errorf("expected the retry to wait at least %s, waited %s", tt.retryAfter, elapsed)
+			}
+		})
+	}
+}
+
+func TestConfigureRequestTimeout(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestTimeout string
+		envTimeout     string
+		want           time.Duration
+		wantErr        bool
+	}{
+		{
+			name: "unset",
+			want: 60 * time.Second,
+		},
+		{
+			name:           "set",
+			requestTimeout: "2m",
+			want:           2 * time.Minute,
+		},
+		{
+			name:       "env",
+			envTimeout: "30s",
+			want:       30 * time.Second,
+		},
+		{
+			name:           "set-overrides-env",
+			requestTimeout: "5m",
+			envTimeout:     "30s",
+			want:           5 * time.Minute,
+		},
+		{
+			name:           "invalid",
+			requestTimeout: "2",
+			wantErr:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(api.EnvVaultClientTimeout, tt.envTimeout)
+
+			client, err := api.NewClient(api.DefaultConfig())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = configureRequestTimeout(client, tt.requestTimeout)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("configureRequestTimeout() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if got := client.ClientTimeout(); got != tt.want {
+				t.Errorf("configureRequestTimeout() timeout = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateProviderConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		raw         map[string]interface{}
+		env         map[string]string
+		wantSummary string
+	}{
+		{
+			name: "from-config",
+			raw: map[string]interface{}{
+				consts.FieldAddress: "https://vault.example.com:8200",
+			},
+		},
+		{
+			name: "from-env",
+			raw:  map[string]interface{}{},
+			env: map[string]string{
+				api.EnvVaultAddress: "http://127.0.0.1:8200",
+			},
+		},
+		{
+			name: "from-agent-env",
+			raw:  map[string]interface{}{},
+			env: map[string]string{
+				api.EnvVaultAgentAddr: "unix:///var/run/vault-agent.sock",
+			},
+		},
+		{
+			name:        "missing",
+			raw:         map[string]interface{}{},
+			wantSummary: "Missing Vault address",
+		},
+		{
+			name: "no-scheme",
+			raw: map[string]interface{}{
+				consts.FieldAddress: "vault.example.com:8200",
+			},
+			wantSummary: "Invalid Vault address",
+		},
+		{
+			name: "no-host",
+			raw: map[string]interface{}{
+				consts.FieldAddress: "https://",
+			},
+			wantSummary: "Invalid Vault address",
+		},
+		{
+			name: "no-socket-path",
+			raw: map[string]interface{}{
+				consts.FieldAddress: "unix://",
+			},
+			wantSummary: "Invalid Vault address",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, k := range []string{
+				api.EnvVaultAddress,
+				api.EnvVaultAgentAddr,
+			} {
+				t.Setenv(k, tt.env[k])
+			}
+
+			d := schema.TestResourceDataRaw(t, NewProvider(nil, nil).Schema, tt.raw)
+			diags := validateProviderConfig(d)
+			if tt.wantSummary == "" {
+				if diags.HasError() {
+					t.Fatalf("validateProviderConfig() unexpected diagnostics %#v", diags)
+				}
+				return
+			}
+
+			if len(diags) != 1 {
+				t.Fatalf("validateProviderConfig() expected 1 diagnostic, actual %#v", diags)
+			}
+
+			if diags[0].Summary != tt.wantSummary {
+				t.Errorf("validateProviderConfig() summary = %q, want %q", diags[0].Summary, tt.wantSummary)
+			}
+
+			if want := cty.GetAttrPath(consts.FieldAddress); !diags[0].AttributePath.Equals(want) {
+				t.Errorf("validateProviderConfig() path = %#v, want %#v", diags[0].AttributePath, want)
+			}
+		})
+	}
+}
+
+func TestProviderMeta_checkConnectivity(t *testing.T) {
+	tests := []struct {
+		name        string
+		health      map[string]interface{}
+		wantVersion string
+		wantErr     bool
+	}{
+		{
+			name: "unsealed",
+			health: map[string]interface{}{
+				"initialized": true,
+				"sealed":      false,
+			},
+			wantVersion: "1.15.0+ent",
+		},
+		{
+			name: "sealed",
+			health: map[string]interface{}{
+				"initialized": true,
+				"sealed":      true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "uninitialized",
+			health: map[string]interface{}{
+				"initialized": false,
+				"sealed":      true,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				var resp interface{}
+				switch req.URL.Path {
+				case "/v1/sys/health":
+					resp = tt.health
+				case "/v1/sys/seal-status":
+					resp = map[string]interface{}{
+						"version": "1.15.0+ent",
+					}
+				default:
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(resp)
+			}))
+			defer ts.Close()
+
+			config := api.DefaultConfig()
+			config.Address = ts.URL
+			client, err := api.NewClient(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			p := &ProviderMeta{
+				client:       client,
+				resourceData: schema.TestResourceDataRaw(t, NewProvider(nil, nil).Schema, map[string]interface{}{}),
+			}
+
+			err = p.checkConnectivity()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkConnectivity() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if p.vaultVersion == nil || p.vaultVersion.String() != tt.wantVersion {
+				t.Fatalf("checkConnectivity() expected version %q, actual %v", tt.wantVersion, p.vaultVersion)
+			}
+
+			if !p.IsEnterpriseSupported() {
+				t.Fatalf("checkConnectivity() expected enterprise to be detected")
+			}
+		})
+	}
+}
+
+// newTestProviderMetaWithVersion returns a ProviderMeta for the Vault server
+// version v. When v is empty, the version can not be determined, unless
+// skip_get_vault_version is set in raw.
+func newTestProviderMetaWithVersion(t *testing.T, v string, raw map[string]interface{}) *ProviderMeta {
+	t.Helper()
+
+	if raw == nil {
+		raw = map[string]interface{}{}
+	}
+
+	meta := &ProviderMeta{
+		resourceData: schema.TestResourceDataRaw(t, NewProvider(nil, nil).Schema, raw),
+	}
+
+	if v != "" {
+		meta.vaultVersion = version.Must(version.NewVersion(v))
+		return meta
+	}
+
+	config, ln := testutil.TestHTTPServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(func() { ln.Close() })
+
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetMaxRetries(0)
+	meta.client = client
+
+	return meta
+}
+
+func TestCheckFeatureSupported(t *testing.T) {
+	tests := []struct {
+		name       string
+		version    string
+		minVersion *version.Version
+		enterprise bool
+		raw        map[string]interface{}
+		wantErr    bool
+	}{
+		{
+			name:       "supported",
+			version:    "1.16.0",
+			minVersion: VaultVersion116,
+		},
+		{
+			name:       "supported-enterprise",
+			version:    "1.16.0+ent",
+			minVersion: VaultVersion116,
+			enterprise: true,
+		},
+		{
+			name:       "unsupported-version",
+			version:    "1.15.0+ent",
+			minVersion: VaultVersion116,
+			enterprise: true,
+			wantErr:    true,
+		},
+		{
+			name:       "unsupported-enterprise",
+			version:    "1.16.0",
+			minVersion: VaultVersion116,
+			enterprise: true,
+			wantErr:    true,
+		},
+		{
+			name:       "unknown-version",
+			minVersion: VaultVersion116,
+			wantErr:    true,
+		},
+		{
+			name:       "unknown-version-skipped",
+			minVersion: VaultVersion116,
+			raw: map[string]interface{}{
+				consts.FieldSkipGetVaultVersion: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := newTestProviderMetaWithVersion(t, tt.version, tt.raw)
+
+			err := CheckFeatureSupported(meta, tt.minVersion, tt.enterprise)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckFeatureSupported() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetToken(t *testing.T) {
+	// the home directory is changed by each test case
+	homedir.DisableCache = true
+	t.Cleanup(func() { homedir.DisableCache = false })
+
+	writeTokenHelper := func(t *testing.T, dir, token string) string {
+		t.Helper()
+
+		filename := filepath.Join(dir, "token-helper-"+token)
+		script := fmt.Sprintf("#!/bin/sh\nif [ \"$1\" = \"get\" ]; then echo %s; fi\n", token)
+		if err := os.WriteFile(filename, []byte(script), 0o700); err != nil {
+			t.Fatal(err)
+		}
+
+		return filename
+	}
+
+	tests := []struct {
+		name         string
+		raw          map[string]interface{}
+		envToken     string
+		fileToken    string
+		configHelper string
+		envHelper    string
+		invalidEnv   bool
+		want         string
+		wantErr      bool
+	}{
+		{
+			name: "none",
+			want: "",
+		},
+		{
+			name:      "token-file",
+			fileToken: "file-token",
+			want:      "file-token",
+		},
+		{
+			name:         "config-token-helper",
+			fileToken:    "file-token",
+			configHelper: "config-helper-token",
+			want:         "config-helper-token",
+		},
+		{
+			name:         "env-token-helper",
+			fileToken:    "file-token",
+			configHelper: "config-helper-token",
+			envHelper:    "env-helper-token",
+			want:         "env-helper-token",
+		},
+		{
+			name:       "invalid-env-token-helper",
+			invalidEnv: true,
+			wantErr:    true,
+		},
+		{
+			name:      "env-token",
+			envToken:  "env-token",
+			fileToken: "file-token",
+			envHelper: "env-helper-token",
+			want:      "env-token",
+		},
+		{
+			name: "config-token",
+			raw: map[string]interface{}{
+				consts.FieldToken: "config-token",
+			},
+			envToken:  "env-token",
+			envHelper: "env-helper-token",
+			want:      "config-token",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			t.Setenv("HOME", dir)
+			t.Setenv(api.EnvVaultToken, tt.envToken)
+			t.Setenv(consts.EnvVarVaultConfigPath, filepath.Join(dir, ".vault"))
+			t.Setenv(consts.EnvVarVaultTokenHelper, "")
+
+			if tt.fileToken != "" {
+				if err := os.WriteFile(filepath.Join(dir, ".vault-token"), []byte(tt.fileToken), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if tt.configHelper != "" {
+				config := fmt.Sprintf("token_helper = %q\n", writeTokenHelper(t, dir, tt.configHelper))
+				if err := os.WriteFile(filepath.Join(dir, ".vault"), []byte(config), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if tt.envHelper != "" {
+				t.Setenv(consts.EnvVarVaultTokenHelper, writeTokenHelper(t, dir, tt.envHelper))
+			}
+
+			if tt.invalidEnv {
+				t.Setenv(consts.EnvVarVaultTokenHelper, filepath.Join(dir, "missing"))
+			}
+
+			raw := tt.raw
+			if raw == nil {
+				raw = map[string]interface{}{}
+			}
+
+			got, err := GetToken(schema.TestResourceDataRaw(t, NewProvider(nil, nil).Schema, raw))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("GetToken() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetVaultAddress(t *testing.T) {
+	tests := []struct {
+		name          string
+		raw           map[string]interface{}
+		env           map[string]string
+		wantAddr      string
+		wantAgentAddr bool
+	}{
+		{
+			name: "from-config",
+			raw: map[string]interface{}{
+				consts.FieldAddress: "https://vault.example.com:8200",
+			},
+			env: map[string]string{
+				api.EnvVaultAddress:   "https://env.example.com:8200",
+				api.EnvVaultAgentAddr: "http://127.0.0.1:8100",
+			},
+			wantAddr: "https://vault.example.com:8200",
+		},
+		{
+			name: "from-env",
+			raw:  map[string]interface{}{},
+			env: map[string]string{
+				api.EnvVaultAddress:   "https://env.example.com:8200",
+				api.EnvVaultAgentAddr: "http://127.0.0.1:8100",
+			},
+			wantAddr: "https://env.example.com:8200",
+		},
+		{
+			name: "from-agent-env",
+			raw:  map[string]interface{}{},
+			env: map[string]string{
+				api.EnvVaultAgentAddr: "http://127.0.0.1:8100",
+			},
+			wantAddr:      "http://127.0.0.1:8100",
+			wantAgentAddr: true,
+		},
+		{
+			name: "unix-socket",
+			raw: map[string]interface{}{
+				consts.FieldAddress: "unix:///var/run/vault-agent.sock",
+			},
+			wantAddr:      "unix:///var/run/vault-agent.sock",
+			wantAgentAddr: true,
+		},
+		{
+			name: "unset",
+			raw:  map[string]interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, k := range []string{
+				api.EnvVaultAddress,
+				api.EnvVaultAgentAddr,
+			} {
+				t.Setenv(k, tt.env[k])
+			}
+
+			d := schema.TestResourceDataRaw(t, NewProvider(nil, nil).Schema, tt.raw)
+			addr, agentAddr := getVaultAddress(d)
+			if addr != tt.wantAddr {
+				t.Errorf("getVaultAddress() addr = %q, want %q", addr, tt.wantAddr)
+			}
+
+			if agentAddr != tt.wantAgentAddr {
+				t.Errorf("getVaultAddress() agentAddr = %t, want %t", agentAddr, tt.wantAgentAddr)
+			}
+		})
+	}
+}
+
+func TestNewProviderMeta_vaultAddress(t *testing.T) {
+	newServer := func(t *testing.T, requests *int) string {
+		config, ln := testutil.TestHTTPServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*requests++
+			var response map[string]interface{}
+			switch r.URL.Path {
+			case "/v1/auth/token/lookup-self":
+				response = map[string]interface{}{
+					"data": map[string]interface{}{
+						"id":  "test-token",
+						"ttl": 3600,
+					},
+				}
+			case "/v1/auth/token/create":
+				response = map[string]interface{}{
+					"auth": map[string]interface{}{
+						"client_token":   "child-token",
+						"lease_duration": 3600,
+					},
+				}
+			default:
+				w.WriteHeader(http.StatusNotImplemented)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(response)
+		}))
+		t.Cleanup(func() { ln.Close() })
+
+		return config.Address
+	}
+
+	tests := []struct {
+		name      string
+		useConfig bool
+		useEnv    bool
+		wantAgent bool
+	}{
+		{
+			name:      "config-over-agent",
+			useConfig: true,
+		},
+		{
+			name:   "env-over-agent",
+			useEnv: true,
+		},
+		{
+			name:      "agent",
+			wantAgent: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var vaultRequests, agentRequests int
+			vaultAddr := newServer(t, &vaultRequests)
+			agentAddr := newServer(t, &agentRequests)
+
+			raw := map[string]interface{}{
+				consts.FieldToken: "test-token",
+			}
+			if tt.useConfig {
+				raw[consts.FieldAddress] = vaultAddr
+			}
+
+			env := map[string]string{
+				api.EnvVaultAgentAddr: agentAddr,
+			}
+			if tt.useEnv {
+				env[api.EnvVaultAddress] = vaultAddr
+			}
+			for _, k := range []string{
+				api.EnvVaultAddress,
+				api.EnvVaultAgentAddr,
+			} {
+				t.Setenv(k, env[k])
+			}
+
+			d := schema.TestResourceDataRaw(t, NewProvider(nil, nil).Schema, raw)
+			meta, err := NewProviderMeta(d)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			wantAddr, wantRequests, otherRequests := vaultAddr, &vaultRequests, &agentRequests
+			if tt.wantAgent {
+				wantAddr, wantRequests, otherRequests = agentAddr, &agentRequests, &vaultRequests
+			}
+
+			if got := meta.(*ProviderMeta).MustGetClient().Address(); got != wantAddr {
+				t.Errorf("NewProviderMeta() client address = %q, want %q", got, wantAddr)
+			}
+			if *wantRequests == 0 {
+				t.Errorf("NewProviderMeta() expected requests to %q", wantAddr)
+			}
+			if *otherRequests != 0 {
+				t.Errorf("NewProviderMeta() expected no requests to other address, got %d", *otherRequests)
+			}
+		})
+	}
+}
+
+func TestNewProviderMeta_agentTokenSink(t *testing.T) {
+	var gotTokens []string
+	config, ln := testutil.TestHTTPServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var response map[string]interface{}
+		switch r.URL.Path {
+		case "/v1/auth/token/lookup-self":
+			gotTokens = append(gotTokens, r.Header.Get(vault_consts.AuthHeaderName))
+			response = map[string]interface{}{
+				"data": map[string]interface{}{
+					"id":  "sink-token",
+					"ttl": 3600,
+				},
+			}
+		case "/v1/auth/token/create":
+			gotTokens = append(gotTokens, r.Header.Get(vault_consts.AuthHeaderName))
+			response = map[string]interface{}{
+				"auth": map[string]interface{}{
+					"client_token":   "child-token",
+					"lease_duration": 3600,
+				},
+			}
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(func() { ln.Close() })
+
+	tempDir := t.TempDir()
+	sinkFile := filepath.Join(tempDir, "sink")
+	if err := os.WriteFile(sinkFile, []byte("sink-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// ensure that no other token source is found
+	t.Setenv("HOME", tempDir)
+	t.Setenv(api.EnvVaultToken, "")
+	t.Setenv(api.EnvVaultAddress, "")
+	t.Setenv(api.EnvVaultAgentAddr, config.Address)
+	t.Setenv(consts.EnvVarTokenFilename, sinkFile)
+
+	d := schema.TestResourceDataRaw(t, NewProvider(nil, nil).Schema, map[string]interface{}{})
+	meta, err := NewProviderMeta(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := meta.(*ProviderMeta).GetClient(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(gotTokens) == 0 {
+		t.Fatalf("NewProviderMeta() expected requests to the Vault Agent")
+	}
+	for _, got := range gotTokens {
+		if got != "sink-token" {
+			t.Errorf("NewProviderMeta() request token = %q, want %q", got, "sink-token")
+		}
+	}
+}
+
+func TestCheckEnterpriseSupported(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		raw     map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name:    "enterprise",
+			version: "1.15.0+ent",
+			raw:     map[string]interface{}{},
+		},
+		{
+			name:    "not-enterprise",
+			version: "1.15.0",
+			raw:     map[string]interface{}{},
+			wantErr: true,
+		},
+		{
+			name:    "version-override",
+			version: "1.15.0",
+			raw: map[string]interface{}{
+				consts.FieldVaultVersionOverride: "1.15.0",
+			},
+		},
+		{
+			name:    "unknown-version",
+			raw:     map[string]interface{}{},
+			wantErr: true,
+		},
+		{
+			name: "unknown-version-skipped",
+			raw: map[string]interface{}{
+				consts.FieldSkipGetVaultVersion: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := newTestProviderMetaWithVersion(t, tt.version, tt.raw)
+
+			err := CheckEnterpriseSupported(meta, "vault_namespace")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckEnterpriseSupported() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckCapabilitySupported(t *testing.T) {
+	checks := map[Capability]func(interface{}, string) error{
+		CapabilityNamespaces:             CheckNamespacesSupported,
+		CapabilitySentinel:               CheckSentinelSupported,
+		CapabilityPerformanceReplication: CheckPerformanceReplicationSupported,
+		CapabilityKMIP:                   CheckKMIPSupported,
+		CapabilityTransform:              CheckTransformSupported,
+	}
+
+	tests := []struct {
+		name         string
+		version      string
+		raw          map[string]interface{}
+		status       int
+		features     []string
+		wantErr      map[Capability]bool
+		wantRequests int
+	}{
+		{
+			name:     "licensed",
+			version:  "1.15.0+ent",
+			status:   http.StatusOK,
+			features: []string{"Namespaces", "Sentinel", "Performance Replication", "KMIP", "Transform Secrets Engine"},
+			wantErr:  map[Capability]bool{},
+			// the license is only read once
+			wantRequests: 1,
+		},
+		{
+			name:     "partially-licensed",
+			version:  "1.15.0+ent",
+			status:   http.StatusOK,
+			features: []string{"Namespaces", "KMIP"},
+			wantErr: map[Capability]bool{
+				CapabilitySentinel:               true,
+				CapabilityPerformanceReplication: true,
+				CapabilityTransform:              true,
+			},
+			wantRequests: 1,
+		},
+		{
+			name:    "not-enterprise",
+			version: "1.15.0",
+			wantErr: map[Capability]bool{
+				CapabilityNamespaces:             true,
+				CapabilitySentinel:               true,
+				CapabilityPerformanceReplication: true,
+				CapabilityKMIP:                   true,
+				CapabilityTransform:              true,
+			},
+		},
+		{
+			name:         "license-unreadable",
+			version:      "1.15.0+ent",
+			status:       http.StatusForbidden,
+			wantErr:      map[Capability]bool{},
+			wantRequests: 1,
+		},
+		{
+			name:    "version-override",
+			version: "1.15.0+ent",
+			raw: map[string]interface{}{
+				consts.FieldVaultVersionOverride: "1.15.0+ent",
+			},
+			wantErr: map[Capability]bool{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+			config, ln := testutil.TestHTTPServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/sys/license/status" {
+					w.WriteHeader(http.StatusNotImplemented)
+					return
+				}
+
+				requests++
+				w.WriteHeader(tt.status)
+				if tt.status != http.StatusOK {
+					return
+				}
+
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"data": map[string]interface{}{
+						"autoloaded": map[string]interface{}{
+							consts.FieldFeatures: tt.features,
+						},
+					},
+				})
+			}))
+			t.Cleanup(func() { ln.Close() })
+
+			client, err := api.NewClient(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			raw := tt.raw
+			if raw == nil {
+				raw = map[string]interface{}{}
+			}
+
+			meta := &ProviderMeta{
+				client:       client,
+				resourceData: schema.TestResourceDataRaw(t, NewProvider(nil, nil).Schema, raw),
+				vaultVersion: version.Must(version.NewVersion(tt.version)),
+			}
+
+			for capability, check := range checks {
+				err := check(meta, "vault_test")
+				if (err != nil) != tt.wantErr[capability] {
+					t.Errorf("check %q error = %v, wantErr %v", capability, err, tt.wantErr[capability])
+				}
+			}
+
+			if requests != tt.wantRequests {
+				t.Errorf("expected %d license status requests, got %d", tt.wantRequests, requests)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 )
@@ -37,6 +38,10 @@ type Description struct {
 	// EnterpriseOnly defaults to false, but should be marked true if a resource is enterprise only.
 	EnterpriseOnly bool
 
+	// Capability is the Vault Enterprise capability that the resource requires,
+	// it should only be set on resources that are EnterpriseOnly.
+	Capability Capability
+
 	Resource *schema.Resource
 }
 
@@ -72,7 +77,13 @@ func NewProvider(
 			consts.FieldAddress: {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "URL of the root of the target Vault server.",
+				Description: "URL of the root of the target Vault server, or a unix:// socket path.",
+			},
+			consts.FieldProxyURL: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "URL of the HTTP or HTTPS proxy to use when connecting to Vault.",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
 			},
 			"add_address_to_env": {
 				Type:        schema.TypeString,
@@ -86,6 +97,14 @@ func NewProvider(
 				Optional:    true,
 				Description: "Token to use to authenticate to Vault.",
 			},
+			consts.FieldAuthLoginPriority: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The order in which to attempt the configured auth login methods, by block name. May include token to fall back to the provider token.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"token_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -98,6 +117,11 @@ func NewProvider(
 				// Note that this is strongly discouraged due to the potential of exposing sensitive secret data.
 				Description: "Set this to true to prevent the creation of ephemeral child token used by this provider.",
 			},
+			consts.FieldRevokeChildToken: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Set this to true to revoke the ephemeral child token when the provider shuts down.",
+			},
 			consts.FieldCACertFile: {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -133,6 +157,24 @@ func NewProvider(
 				Optional:    true,
 				Description: "Maximum number of retries for Client Controlled Consistency related operations",
 			},
+			consts.FieldMinRetryWait: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Minimum time to wait before retrying a request, as a duration string.",
+				ValidateFunc: ValidateDuration,
+			},
+			consts.FieldMaxRetryWait: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Maximum time to wait before retrying a request, as a duration string.",
+				ValidateFunc: ValidateDuration,
+			},
+			consts.FieldRequestTimeout: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Timeout for each request made to Vault, as a duration string.",
+				ValidateFunc: ValidateDuration,
+			},
 			consts.FieldNamespace: {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -164,6 +206,16 @@ func NewProvider(
 				Optional:    true,
 				Description: "Skip the dynamic fetching of the Vault server version.",
 			},
+			consts.FieldSkipValidation: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Skip the validation of the provider configuration when the provider is configured.",
+			},
+			consts.FieldCheckConnectivity: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Check the connectivity to Vault, and detect the Vault server version, when the provider is configured.",
+			},
 			consts.FieldVaultVersionOverride: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -199,15 +251,15 @@ func NewProvider(
 				},
 			},
 		},
-		ConfigureFunc:  NewProviderMeta,
-		DataSourcesMap: dataSourcesMap,
-		ResourcesMap:   coreResourcesMap,
+		ConfigureContextFunc: ConfigureProvider,
+		DataSourcesMap:       dataSourcesMap,
+		ResourcesMap:         coreResourcesMap,
 	}
 
 	MustAddAuthLoginSchema(r.Schema)
 
 	// Set the provider Meta (instance data) here.
-	// It will be overwritten by the result of the call to ConfigureFunc,
+	// It will be overwritten by the result of the call to ConfigureContextFunc,
 	// but can be used pre-configuration by other (non-primary) provider servers.
 	r.SetMeta(&ProviderMeta{})
 
@@ -218,6 +270,16 @@ func parse(descs map[string]*Description) (map[string]*schema.Resource, error) {
 	var errs error
 	resourceMap := make(map[string]*schema.Resource)
 	for k, desc := range descs {
+		if desc.EnterpriseOnly {
+			check := CheckEnterpriseSupported
+			if desc.Capability != "" {
+				var ok bool
+				if check, ok = capabilityChecks[desc.Capability]; !ok {
+					errs = multierror.Append(errs, fmt.Errorf("%q has an unknown capability %q", k, desc.Capability))
+				}
+			}
+			mustAddEnterpriseCheck(k, desc.Resource, check)
+		}
 		resourceMap[k] = desc.Resource
 		if len(desc.PathInventory) == 0 {
 			errs = multierror.Append(errs, fmt.Errorf("%q needs its paths inventoried", k))
@@ -226,6 +288,71 @@ func parse(descs map[string]*Description) (map[string]*schema.Resource, error) {
 	return resourceMap, errs
 }
 
+// capabilityChecks maps each Capability to the function that checks whether
+// the Vault server supports it.
+var capabilityChecks = map[Capability]func(meta interface{}, name string) error{
+	CapabilityNamespaces:             CheckNamespacesSupported,
+	CapabilitySentinel:               CheckSentinelSupported,
+	CapabilityPerformanceReplication: CheckPerformanceReplicationSupported,
+	CapabilityKMIP:                   CheckKMIPSupported,
+	CapabilityTransform:              CheckTransformSupported,
+}
+
+// mustAddEnterpriseCheck ensures that the enterprise only resource or data
+// source fails early when supported returns an error, e.g. when the Vault
+// server is not Vault Enterprise. Resources are checked when planning
+// changes, data sources prior to being read.
+func mustAddEnterpriseCheck(name string, r *schema.Resource, supported func(meta interface{}, name string) error) {
+	check := func(meta interface{}) error {
+		return supported(meta, name)
+	}
+
+	if r.Create != nil || r.CreateContext != nil || r.CreateWithoutTimeout != nil {
+		customizeDiff := r.CustomizeDiff
+		r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if err := check(meta); err != nil {
+				return err
+			}
+
+			if customizeDiff != nil {
+				return customizeDiff(ctx, d, meta)
+			}
+
+			return nil
+		}
+		return
+	}
+
+	switch {
+	case r.Read != nil:
+		read := r.Read
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			if err := check(meta); err != nil {
+				return err
+			}
+			return read(d, meta)
+		}
+	case r.ReadContext != nil:
+		read := r.ReadContext
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if err := check(meta); err != nil {
+				return diag.FromErr(err)
+			}
+			return read(ctx, d, meta)
+		}
+	case r.ReadWithoutTimeout != nil:
+		read := r.ReadWithoutTimeout
+		r.ReadWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if err := check(meta); err != nil {
+				return diag.FromErr(err)
+			}
+			return read(ctx, d, meta)
+		}
+	default:
+		panic(fmt.Sprintf("no read function found for enterprise only data source %q", name))
+	}
+}
+
 // ReadWrapper provides common read operations to the wrapped schema.ReadFunc.
 func ReadWrapper(f schema.ReadFunc) schema.ReadFunc {
 	return func(d *schema.ResourceData, i interface{}) error {
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestMustAddEnterpriseCheck(t *testing.T) {
+	newMeta := func(t *testing.T, v string) *ProviderMeta {
+		return &ProviderMeta{
+			resourceData: schema.TestResourceDataRaw(t, NewProvider(nil, nil).Schema, map[string]interface{}{}),
+			vaultVersion: version.Must(version.NewVersion(v)),
+		}
+	}
+
+	noop := func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		return nil
+	}
+
+	tests := []struct {
+		name    string
+		version string
+		wantErr bool
+	}{
+		{
+			name:    "enterprise",
+			version: "1.15.0+ent",
+		},
+		{
+			name:    "not-enterprise",
+			version: "1.15.0",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+"-resource", func(t *testing.T) {
+			var called bool
+			r := &schema.Resource{
+				CreateContext: noop,
+				ReadContext:   noop,
+				CustomizeDiff: func(context.Context, *schema.ResourceDiff, interface{}) error {
+					called = true
+					return nil
+				},
+			}
+			mustAddEnterpriseCheck("vault_test", r, CheckEnterpriseSupported)
+
+			err := r.CustomizeDiff(context.Background(), nil, newMeta(t, tt.version))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CustomizeDiff() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if called == tt.wantErr {
+				t.Errorf("CustomizeDiff() expected wrapped CustomizeDiff called=%t", !tt.wantErr)
+			}
+		})
+
+		t.Run(tt.name+"-data-source", func(t *testing.T) {
+			r := &schema.Resource{
+				ReadContext: noop,
+			}
+			mustAddEnterpriseCheck("vault_test", r, CheckEnterpriseSupported)
+
+			diags := r.ReadContext(context.Background(), nil, newMeta(t, tt.version))
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("ReadContext() diags = %#v, wantErr %v", diags, tt.wantErr)
+			}
+		})
+	}
+}
@@ -62,6 +62,7 @@ type Metadata struct {
 	CreatedTime    string                 `json:"created_time" mapstructure:"created_time"`
 	DeletionTime   string                 `json:"deletion_time" mapstructure:"deletion_time"`
 	Destroyed      bool                   `json:"destroyed" mapstructure:"destroyed"`
+	Version        int32                  `json:"version" mapstructure:"version"`
 }
 
 // Schema defines this resource's schema which is the data that is available in
@@ -81,7 +82,8 @@ func (r *KVV2EphemeralSecretResource) Schema(_ context.Context, _ ephemeral.Sche
 			},
 			consts.FieldVersion: schema.Int32Attribute{
 				Optional:            true,
-				MarkdownDescription: "Version of the secret to retrieve.",
+				Computed:            true,
+				MarkdownDescription: "Version of the secret to retrieve. Defaults to the latest version.",
 			},
 			consts.FieldDataJSON: schema.StringAttribute{
 				MarkdownDescription: "JSON-encoded secret data read from Vault.",
@@ -121,6 +123,8 @@ func (r *KVV2EphemeralSecretResource) Metadata(ctx context.Context, req ephemera
 	resp.TypeName = req.ProviderTypeName + "_kv_secret_v2"
 }
 
+// Open reads the KV-V2 secret from Vault. KV secrets are not leased, so the
+// resource does not implement Renew or Close.
 func (r *KVV2EphemeralSecretResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
 	var data KVV2EphemeralSecretModel
 	// Read Terraform prior state data into the model
@@ -136,7 +140,6 @@ func (r *KVV2EphemeralSecretResource) Open(ctx context.Context, req ephemeral.Op
 		return
 	}
 
-	// read the name from the id field to support the import command
 	path := r.path(data.Mount.ValueString(), data.Name.ValueString())
 
 	var secretResp *api.Secret
@@ -173,28 +176,63 @@ func (r *KVV2EphemeralSecretResource) Open(ctx context.Context, req ephemeral.Op
 		return
 	}
 
+	// Vault returns the metadata without any data for a version that was
+	// deleted or destroyed
+	if readResp.Data == nil {
+		resp.Diagnostics.AddError(
+			"Secret version not available",
+			fmt.Sprintf("Version %d of the secret at %q has been deleted or destroyed", readResp.Metadata.Version, path),
+		)
+
+		return
+	}
+
 	data.CreatedTime = types.StringValue(readResp.Metadata.CreatedTime)
 	data.DeletionTime = types.StringValue(readResp.Metadata.DeletionTime)
 	data.Destroyed = types.BoolValue(readResp.Metadata.Destroyed)
+	data.Version = types.Int32Value(readResp.Metadata.Version)
 
-	secretData, diag := types.MapValueFrom(ctx, types.StringType, readResp.Data)
+	secretData, diag := types.MapValueFrom(ctx, types.StringType, serializeDataMapToString(readResp.Data))
 	resp.Diagnostics.Append(diag...)
 	data.Data = secretData
 
-	jsonData, err := json.Marshal(data.Data)
+	jsonData, err := json.Marshal(readResp.Data)
 	if err != nil {
 		resp.Diagnostics.AddError("Error marshalling data to JSON", err.Error())
+		return
 	}
 
 	data.DataJSON = types.StringValue(string(jsonData))
 
-	secretCustomMetadata, diag := types.MapValueFrom(ctx, types.StringType, readResp.Metadata.CustomMetadata)
+	secretCustomMetadata, diag := types.MapValueFrom(ctx, types.StringType, serializeDataMapToString(readResp.Metadata.CustomMetadata))
 	resp.Diagnostics.Append(diag...)
 	data.CustomMetadata = secretCustomMetadata
 
 	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
 }
 
+// serializeDataMapToString converts the secret data to a map of strings, string
+// values are kept as-is and all other values, such as nested objects, numbers
+// and booleans, are serialized as JSON.
+func serializeDataMapToString(data map[string]interface{}) map[string]string {
+	if data == nil {
+		return nil
+	}
+
+	dataMap := make(map[string]string, len(data))
+	for k, v := range data {
+		if vs, ok := v.(string); ok {
+			dataMap[k] = vs
+		} else {
+			// the value came from JSON and so can always be marshaled
+			vBytes, _ := json.Marshal(v)
+			dataMap[k] = string(vBytes)
+		}
+	}
+
+	return dataMap
+}
+
 func (r *KVV2EphemeralSecretResource) path(mount, name string) string {
 	return fmt.Sprintf("%s/data/%s", mount, name)
 }
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralsecrets
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_serializeDataMapToString(t *testing.T) {
+	tests := []struct {
+		name string
+		data map[string]interface{}
+		want map[string]string
+	}{
+		{
+			name: "nil",
+			data: nil,
+			want: nil,
+		},
+		{
+			name: "strings",
+			data: map[string]interface{}{
+				"foo": "bar",
+			},
+			want: map[string]string{
+				"foo": "bar",
+			},
+		},
+		{
+			name: "non-strings",
+			data: map[string]interface{}{
+				"num":    float64(1),
+				"bool":   true,
+				"nested": map[string]interface{}{"baz": "qux"},
+				"list":   []interface{}{"a", "b"},
+				"null":   nil,
+			},
+			want: map[string]string{
+				"num":    "1",
+				"bool":   "true",
+				"nested": `{"baz":"qux"}`,
+				"list":   `["a","b"]`,
+				"null":   "null",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := serializeDataMapToString(tt.data); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("serializeDataMapToString() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
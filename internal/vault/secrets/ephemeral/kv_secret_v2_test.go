@@ -47,6 +47,31 @@ func TestAccKVV2Secret(t *testing.T) {
 	})
 }
 
+// TestAccKVV2Secret_version confirms that the version of the secret
+// that was read is set in the ephemeral resource
+func TestAccKVV2Secret_version(t *testing.T) {
+	testutil.SkipTestAcc(t)
+
+	mount := acctest.RandomWithPrefix("kvv2-mount")
+	name := acctest.RandomWithPrefix("secret")
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testKVV2SecretConfig_version(mount, name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test_krb", tfjsonpath.New("data").AtMapKey("version"), knownvalue.Int64Exact(1)),
+					statecheck.ExpectKnownValue("echo.test_krb", tfjsonpath.New("data").AtMapKey("created_time"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
 func testKVV2SecretConfig(mount, name string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "kvv2" {
@@ -79,3 +104,39 @@ provider "echo" {
 resource "echo" "test_krb" {}
 `, mount, name)
 }
+
+func testKVV2SecretConfig_version(mount, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path        = "%s"
+  type        = "kv"
+  options     = { version = "2" }
+}
+
+resource "vault_kv_secret_v2" "secret" {
+  mount                = vault_mount.kvv2.path
+  name                 = "%s"
+  data_json_wo         = jsonencode(
+    {
+      password       = "password1"
+    }
+  )
+  data_json_wo_version = 0
+}
+
+ephemeral "vault_kv_secret_v2" "db_secret" {
+	mount    = vault_mount.kvv2.path
+	mount_id = vault_mount.kvv2.id
+	name     = vault_kv_secret_v2.secret.name
+}
+
+provider "echo" {
+	data = {
+		version      = ephemeral.vault_kv_secret_v2.db_secret.version
+		created_time = ephemeral.vault_kv_secret_v2.db_secret.created_time
+	}
+}
+
+resource "echo" "test_krb" {}
+`, mount, name)
+}
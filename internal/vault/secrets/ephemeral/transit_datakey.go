@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralsecrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+const (
+	transitDataKeyTypePlaintext = "plaintext"
+	transitDataKeyTypeWrapped   = "wrapped"
+)
+
+// Ensure the implementation satisfies the ephemeral.EphemeralResource interface
+var _ ephemeral.EphemeralResource = &TransitDataKeyEphemeralResource{}
+
+// NewTransitDataKeyEphemeralResource returns the implementation for this resource to be
+// imported by the Terraform Plugin Framework provider
+var NewTransitDataKeyEphemeralResource = func() ephemeral.EphemeralResource {
+	return &TransitDataKeyEphemeralResource{}
+}
+
+// TransitDataKeyEphemeralResource implements the methods that define this resource
+type TransitDataKeyEphemeralResource struct {
+	base.EphemeralResourceWithConfigure
+}
+
+// TransitDataKeyModel describes the Terraform resource data model to match the
+// resource schema.
+type TransitDataKeyModel struct {
+	// common fields to all ephemeral resources
+	base.BaseModelEphemeral
+
+	// fields specific to this resource
+	Mount   types.String `tfsdk:"mount"`
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+	Context types.String `tfsdk:"context"`
+	Nonce   types.String `tfsdk:"nonce"`
+	Bits    types.Int64  `tfsdk:"bits"`
+
+	// computed fields
+	Plaintext  types.String `tfsdk:"plaintext"`
+	Ciphertext types.String `tfsdk:"ciphertext"`
+	KeyVersion types.Int64  `tfsdk:"key_version"`
+}
+
+// Schema defines this resource's schema which is the data that is available in
+// the resource's configuration, plan, and state
+func (r *TransitDataKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Mount path for the Transit engine in Vault.",
+				Required:            true,
+			},
+			consts.FieldName: schema.StringAttribute{
+				MarkdownDescription: "Name of the transit key that encrypts the data key.",
+				Required:            true,
+			},
+			consts.FieldType: schema.StringAttribute{
+				MarkdownDescription: "Type of the data key, `plaintext` returns the plaintext and the " +
+					"ciphertext of the data key, `wrapped` only returns the ciphertext. Defaults to `plaintext`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(transitDataKeyTypePlaintext, transitDataKeyTypeWrapped),
+				},
+			},
+			consts.FieldContext: schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded context for key derivation, required if the transit key " +
+					"has derivation enabled.",
+				Optional: true,
+			},
+			consts.FieldNonce: schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded nonce, only used by transit keys with convergent encryption " +
+					"enabled on Vault versions before 0.6.2.",
+				Optional: true,
+			},
+			consts.FieldBits: schema.Int64Attribute{
+				MarkdownDescription: "Number of bits of the data key, one of 128, 256 or 512. Defaults to 256.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(128, 256, 512),
+				},
+			},
+			consts.FieldPlaintext: schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded plaintext of the data key, only set for the `plaintext` type.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			consts.FieldCiphertext: schema.StringAttribute{
+				MarkdownDescription: "The data key encrypted with the transit key.",
+				Computed:            true,
+			},
+			consts.FieldKeyVersion: schema.Int64Attribute{
+				MarkdownDescription: "Version of the transit key that encrypted the data key.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: "Provides an ephemeral resource to generate a data key with a Transit key " +
+			"for envelope encryption.",
+	}
+
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+}
+
+// Metadata sets the full name for this resource
+func (r *TransitDataKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transit_datakey"
+}
+
+// Open generates a new data key. Data keys are not leased, so the resource
+// does not implement Renew or Close.
+func (r *TransitDataKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data TransitDataKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	keyType := transitDataKeyTypePlaintext
+	if !data.Type.IsNull() {
+		keyType = data.Type.ValueString()
+	}
+
+	reqData := map[string]interface{}{}
+	if !data.Context.IsNull() {
+		reqData[consts.FieldContext] = data.Context.ValueString()
+	}
+	if !data.Nonce.IsNull() {
+		reqData[consts.FieldNonce] = data.Nonce.ValueString()
+	}
+	if !data.Bits.IsNull() {
+		reqData[consts.FieldBits] = data.Bits.ValueInt64()
+	}
+
+	path := r.path(data.Mount.ValueString(), keyType, data.Name.ValueString())
+
+	log.Printf("[DEBUG] Generating transit data key at %q", path)
+	secret, err := c.Logical().WriteWithContext(ctx, path, reqData)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+		return
+	}
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+	log.Printf("[DEBUG] Generated transit data key at %q", path)
+
+	ciphertext, ok := secret.Data[consts.FieldCiphertext].(string)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid response from Vault",
+			fmt.Sprintf("%s field not found or not a string in Vault response", consts.FieldCiphertext),
+		)
+		return
+	}
+	data.Ciphertext = types.StringValue(ciphertext)
+
+	// the plaintext is only returned for the plaintext type
+	data.Plaintext = types.StringNull()
+	if v, ok := secret.Data[consts.FieldPlaintext].(string); ok {
+		data.Plaintext = types.StringValue(v)
+	}
+
+	data.KeyVersion = types.Int64Null()
+	if v, ok := secret.Data[consts.FieldKeyVersion].(json.Number); ok {
+		if keyVersion, err := v.Int64(); err == nil {
+			data.KeyVersion = types.Int64Value(keyVersion)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *TransitDataKeyEphemeralResource) path(mount, keyType, name string) string {
+	return fmt.Sprintf("%s/datakey/%s/%s", strings.Trim(mount, "/"), keyType, strings.Trim(name, "/"))
+}
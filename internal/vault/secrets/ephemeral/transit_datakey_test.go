@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralsecrets_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+// TestAccTransitDataKey confirms that a data key generated with a transit
+// key is set in the ephemeral resource
+func TestAccTransitDataKey(t *testing.T) {
+	testutil.SkipTestAcc(t)
+
+	mount := acctest.RandomWithPrefix("transit-mount")
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTransitDataKeyConfig(mount, "plaintext"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("plaintext"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("ciphertext"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^vault:v1:`))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("key_version"), knownvalue.Int64Exact(1)),
+				},
+			},
+			{
+				Config: testTransitDataKeyConfig(mount, "wrapped"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("plaintext"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("ciphertext"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^vault:v1:`))),
+				},
+			},
+		},
+	})
+}
+
+func testTransitDataKeyConfig(mount, keyType string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "key" {
+  backend = vault_mount.transit.path
+  name    = "test"
+}
+
+ephemeral "vault_transit_datakey" "key" {
+  mount    = vault_mount.transit.path
+  mount_id = vault_mount.transit.id
+  name     = vault_transit_secret_backend_key.key.name
+  type     = "%s"
+  bits     = 256
+}
+
+provider "echo" {
+  data = {
+    plaintext   = ephemeral.vault_transit_datakey.key.plaintext
+    ciphertext  = ephemeral.vault_transit_datakey.key.ciphertext
+    key_version = ephemeral.vault_transit_datakey.key.key_version
+  }
+}
+
+resource "echo" "test" {}
+`, mount, keyType)
+}
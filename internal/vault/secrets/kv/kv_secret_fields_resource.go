@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kv
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+const dataAffix = "data"
+
+// Ensure the implementation satisfies the resource.ResourceWithConfigure interface
+var _ resource.ResourceWithConfigure = &KVSecretFieldsResource{}
+
+// NewKVSecretFieldsResource returns the implementation for this resource to be
+// imported by the Terraform Plugin Framework provider
+func NewKVSecretFieldsResource() resource.Resource { return &KVSecretFieldsResource{} }
+
+// KVSecretFieldsResource implements the methods that define this resource
+type KVSecretFieldsResource struct {
+	base.ResourceWithConfigure
+}
+
+// KVSecretFieldsModel describes the Terraform resource data model to match the
+// resource schema.
+type KVSecretFieldsModel struct {
+	base.BaseModelLegacy
+
+	Mount types.String `tfsdk:"mount"`
+	Name  types.String `tfsdk:"name"`
+	Data  types.Map    `tfsdk:"data"`
+}
+
+func (r *KVSecretFieldsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kv_secret_fields"
+}
+
+func (r *KVSecretFieldsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the KV-V2 engine is mounted.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldName: schema.StringAttribute{
+				MarkdownDescription: "Full name of the secret. For a nested secret, the name is the nested path " +
+					"excluding the mount and data prefix.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldData: schema.MapAttribute{
+				MarkdownDescription: "The keys of the secret that are managed by this resource, " +
+					"all other keys of the secret are left untouched.",
+				ElementType: types.StringType,
+				Required:    true,
+				Sensitive:   true,
+			},
+		},
+		MarkdownDescription: "Manage a subset of the keys of a KV-V2 secret.",
+	}
+	base.MustAddLegacyBaseSchema(&resp.Schema)
+}
+
+// Create is called during the terraform apply command.
+//
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/create
+func (r *KVSecretFieldsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KVSecretFieldsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields, diags := fieldsFromModel(ctx, &data, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.patch(ctx, &data, fields, true); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+		return
+	}
+
+	data.ID = types.StringValue(r.path(&data))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called during the terraform apply, terraform plan, and terraform
+// refresh commands.
+//
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/read
+func (r *KVSecretFieldsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data KVSecretFieldsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	readResp, err := cli.Logical().ReadWithContext(ctx, r.path(&data))
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+
+	if readResp == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// the data is nil when the latest version was deleted
+	secretData, _ := readResp.Data[consts.FieldData].(map[string]interface{})
+	if secretData == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var managed map[string]string
+	resp.Diagnostics.Append(data.Data.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only the managed keys are read, keys that were removed outside of
+	// Terraform are dropped so that they are written again
+	fields := map[string]string{}
+	for k := range managed {
+		v, ok := secretData[k]
+		if !ok {
+			continue
+		}
+
+		if s, ok := v.(string); ok {
+			fields[k] = s
+		} else {
+			b, _ := json.Marshal(v)
+			fields[k] = string(b)
+		}
+	}
+
+	m, diags := types.MapValueFrom(ctx, types.StringType, fields)
+	resp.Diagnostics.Append(diags...)
+	data.Data = m
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is called during the terraform apply command
+//
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/update
+func (r *KVSecretFieldsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state KVSecretFieldsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields, diags := fieldsFromModel(ctx, &data, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.patch(ctx, &data, fields, false); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultUpdateErr(err))
+		return
+	}
+
+	data.ID = types.StringValue(r.path(&data))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called during the terraform apply command
+//
+// Only the managed keys are removed from the secret.
+//
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/delete
+func (r *KVSecretFieldsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data KVSecretFieldsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields := map[string]interface{}{}
+	for k := range data.Data.Elements() {
+		fields[k] = nil
+	}
+
+	if err := r.patch(ctx, &data, fields, false); err != nil {
+		// the secret was already deleted
+		if util.Is404(err) {
+			return
+		}
+		resp.Diagnostics.AddError(errutil.VaultDeleteErr(err))
+		return
+	}
+}
+
+// patch merges the fields into the secret, fields with a nil value are
+// removed. If create is true and the secret does not exist yet, it is
+// written with the fields.
+func (r *KVSecretFieldsResource) patch(ctx context.Context, data *KVSecretFieldsModel, fields map[string]interface{}, create bool) error {
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		return err
+	}
+
+	path := r.path(data)
+	body := map[string]interface{}{
+		consts.FieldData: fields,
+	}
+
+	_, err = cli.Logical().JSONMergePatch(ctx, path, body)
+	if err != nil && create && util.Is404(err) {
+		_, err = writeWithCAS(ctx, cli, path, body)
+	}
+
+	return err
+}
+
+func (r *KVSecretFieldsResource) path(data *KVSecretFieldsModel) string {
+	return fmt.Sprintf("%s/%s/%s", data.Mount.ValueString(), dataAffix, data.Name.ValueString())
+}
+
+// writeWithCAS writes a new secret, cas=0 ensures that a secret that was
+// created concurrently is not overwritten.
+func writeWithCAS(ctx context.Context, cli *api.Client, path string, body map[string]interface{}) (*api.Secret, error) {
+	body["options"] = map[string]interface{}{
+		"cas": 0,
+	}
+
+	return cli.Logical().WriteWithContext(ctx, path, body)
+}
+
+// fieldsFromModel returns the fields to patch. If the prior state is provided,
+// keys that are no longer configured are set to nil so that they are removed.
+func fieldsFromModel(ctx context.Context, data, state *KVSecretFieldsModel) (map[string]interface{}, diag.Diagnostics) {
+	fields := map[string]interface{}{}
+	if state != nil {
+		for k := range state.Data.Elements() {
+			fields[k] = nil
+		}
+	}
+
+	var m map[string]string
+	diags := data.Data.ElementsAs(ctx, &m, false)
+	for k, v := range m {
+		fields[k] = v
+	}
+
+	return fields, diags
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kv_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccKVSecretFields(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("tf-secret")
+	resourceName := "vault_kv_secret_fields.test"
+	dataSourceName := "data.vault_kv_secret_v2.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKVSecretFieldsConfig(mount, name, `
+    foo = "bar"
+    zip = "zap"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldID, fmt.Sprintf("%s/data/%s", mount, name)),
+					resource.TestCheckResourceAttr(resourceName, "data.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "data.foo", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "data.zip", "zap"),
+					// the key owned by the vault_kv_secret_v2 resource is kept
+					resource.TestCheckResourceAttr(dataSourceName, "data.%", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "data.owner", "app"),
+				),
+			},
+			{
+				Config: testAccKVSecretFieldsConfig(mount, name, `
+    foo = "baz"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "data.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data.foo", "baz"),
+					resource.TestCheckResourceAttr(dataSourceName, "data.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "data.foo", "baz"),
+					resource.TestCheckResourceAttr(dataSourceName, "data.owner", "app"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKVSecretFieldsConfig(mount, name, fields string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path    = "%s"
+  type    = "kv"
+  options = { version = "2" }
+}
+
+resource "vault_kv_secret_v2" "test" {
+  mount        = vault_mount.kvv2.path
+  name         = "%s"
+  data_json    = jsonencode({ owner = "app" })
+  disable_read = true
+}
+
+resource "vault_kv_secret_fields" "test" {
+  mount = vault_mount.kvv2.path
+  name  = vault_kv_secret_v2.test.name
+  data = {
+%s
+  }
+}
+
+data "vault_kv_secret_v2" "test" {
+  mount      = vault_mount.kvv2.path
+  name       = vault_kv_secret_v2.test.name
+  depends_on = [vault_kv_secret_fields.test]
+}
+`, mount, name, fields)
+}
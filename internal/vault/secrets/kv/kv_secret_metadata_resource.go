@@ -0,0 +1,375 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kv
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+const metadataAffix = "metadata"
+
+var metadataIDRe = regexp.MustCompile(`^(.+?)/` + metadataAffix + `/(.+)$`)
+
+// Ensure the implementation satisfies the resource.ResourceWithConfigure interface
+var _ resource.ResourceWithConfigure = &KVSecretMetadataResource{}
+
+// NewKVSecretMetadataResource returns the implementation for this resource to be
+// imported by the Terraform Plugin Framework provider
+func NewKVSecretMetadataResource() resource.Resource { return &KVSecretMetadataResource{} }
+
+// KVSecretMetadataResource implements the methods that define this resource
+type KVSecretMetadataResource struct {
+	base.ResourceWithConfigure
+}
+
+// KVSecretMetadataModel describes the Terraform resource data model to match the
+// resource schema.
+type KVSecretMetadataModel struct {
+	base.BaseModelLegacy
+
+	Mount              types.String `tfsdk:"mount"`
+	Name               types.String `tfsdk:"name"`
+	MaxVersions        types.Int64  `tfsdk:"max_versions"`
+	CASRequired        types.Bool   `tfsdk:"cas_required"`
+	DeleteVersionAfter types.Int64  `tfsdk:"delete_version_after"`
+	CustomMetadata     types.Map    `tfsdk:"custom_metadata"`
+}
+
+// KVSecretMetadataAPIModel describes the Vault API data model.
+type KVSecretMetadataAPIModel struct {
+	MaxVersions        int64             `json:"max_versions" mapstructure:"max_versions"`
+	CASRequired        bool              `json:"cas_required" mapstructure:"cas_required"`
+	DeleteVersionAfter string            `json:"delete_version_after" mapstructure:"delete_version_after"`
+	CustomMetadata     map[string]string `json:"custom_metadata" mapstructure:"custom_metadata"`
+}
+
+func (r *KVSecretMetadataResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kv_secret_metadata"
+}
+
+func (r *KVSecretMetadataResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the KV-V2 engine is mounted.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldName: schema.StringAttribute{
+				MarkdownDescription: "Full name of the secret. For a nested secret, the name is the nested path " +
+					"excluding the mount and metadata prefix.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldMaxVersions: schema.Int64Attribute{
+				MarkdownDescription: "The number of versions to keep for the secret. " +
+					"If not set, the backend's configured max version is used.",
+				Optional: true,
+				Computed: true,
+			},
+			consts.FieldCASRequired: schema.BoolAttribute{
+				MarkdownDescription: "If true, the secret will require the cas parameter to be set on all write requests.",
+				Optional:            true,
+				Computed:            true,
+			},
+			consts.FieldDeleteVersionAfter: schema.Int64Attribute{
+				MarkdownDescription: "The length of time in seconds before a version is deleted. " +
+					"If not set, the backend's configured delete_version_after is used.",
+				Optional: true,
+				Computed: true,
+			},
+			consts.FieldCustomMetadata: schema.MapAttribute{
+				MarkdownDescription: "A map of arbitrary string to string valued user-provided metadata meant to describe the secret.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+		MarkdownDescription: "Manage the metadata of a KV-V2 secret, independent of the secret data.",
+	}
+	base.MustAddLegacyBaseSchema(&resp.Schema)
+}
+
+// Create is called during the terraform apply command.
+//
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/create
+func (r *KVSecretMetadataResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KVSecretMetadataModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	vaultRequest, diags := buildVaultRequestFromModel(ctx, &data, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	path := makeID(data.Mount.ValueString(), data.Name.ValueString())
+	if _, err := cli.Logical().WriteWithContext(ctx, path, vaultRequest); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+		return
+	}
+
+	data.ID = types.StringValue(path)
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called during the terraform apply, terraform plan, and terraform
+// refresh commands.
+//
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/read
+func (r *KVSecretMetadataResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data KVSecretMetadataModel
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is called during the terraform apply command
+//
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/update
+func (r *KVSecretMetadataResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state KVSecretMetadataModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	vaultRequest, diags := buildVaultRequestFromModel(ctx, &data, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// a patch request is used so that custom metadata keys that were removed
+	// from the configuration can be deleted
+	path := makeID(data.Mount.ValueString(), data.Name.ValueString())
+	if _, err := cli.Logical().JSONMergePatch(ctx, path, vaultRequest); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultUpdateErr(err))
+		return
+	}
+
+	data.ID = types.StringValue(path)
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called during the terraform apply command
+//
+// Deleting the metadata endpoint would permanently delete all versions of the
+// secret, so the metadata is reset to its defaults instead.
+//
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/delete
+func (r *KVSecretMetadataResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data KVSecretMetadataModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	customMetadata := map[string]interface{}{}
+	for k := range data.CustomMetadata.Elements() {
+		customMetadata[k] = nil
+	}
+
+	vaultRequest := map[string]interface{}{
+		consts.FieldMaxVersions:        0,
+		consts.FieldCASRequired:        false,
+		consts.FieldDeleteVersionAfter: 0,
+		consts.FieldCustomMetadata:     customMetadata,
+	}
+
+	path := makeID(data.Mount.ValueString(), data.Name.ValueString())
+	if _, err := cli.Logical().JSONMergePatch(ctx, path, vaultRequest); err != nil {
+		// the secret and its metadata were already deleted
+		if util.Is404(err) {
+			return
+		}
+		resp.Diagnostics.AddError(errutil.VaultDeleteErr(err))
+		return
+	}
+}
+
+func (r *KVSecretMetadataResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := req.ID
+
+	matches := metadataIDRe.FindStringSubmatch(id)
+	if len(matches) != 3 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected ID in format '<mount>/%s/<name>', got: %q", metadataAffix, id),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldMount), matches[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldName), matches[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldID), id)...)
+}
+
+// read populates the model from Vault, the ID is set to null if the metadata
+// does not exist.
+func (r *KVSecretMetadataResource) read(ctx context.Context, data *KVSecretMetadataModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		diags.AddError(errutil.ClientConfigureErr(err))
+		return diags
+	}
+
+	path := makeID(data.Mount.ValueString(), data.Name.ValueString())
+	readResp, err := cli.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		diags.AddError(errutil.VaultReadErr(err))
+		return diags
+	}
+	if readResp == nil {
+		data.ID = types.StringNull()
+		return diags
+	}
+
+	var apiModel KVSecretMetadataAPIModel
+	if err := model.ToAPIModel(readResp.Data, &apiModel); err != nil {
+		diags.AddError("Unable to translate Vault response data", err.Error())
+		return diags
+	}
+
+	// delete_version_after is written as seconds, but is returned as a
+	// duration string of the format "3h12m10s"
+	deleteVersionAfter, err := time.ParseDuration(apiModel.DeleteVersionAfter)
+	if err != nil {
+		diags.AddError("Invalid delete_version_after format from Vault", err.Error())
+		return diags
+	}
+
+	data.MaxVersions = types.Int64Value(apiModel.MaxVersions)
+	data.CASRequired = types.BoolValue(apiModel.CASRequired)
+	data.DeleteVersionAfter = types.Int64Value(int64(deleteVersionAfter.Seconds()))
+
+	// keep custom_metadata null when it is not configured and Vault has none
+	if len(apiModel.CustomMetadata) > 0 || !data.CustomMetadata.IsNull() {
+		customMetadata, d := types.MapValueFrom(ctx, types.StringType, apiModel.CustomMetadata)
+		diags.Append(d...)
+		data.CustomMetadata = customMetadata
+	}
+
+	data.ID = types.StringValue(path)
+
+	return diags
+}
+
+// buildVaultRequestFromModel returns the request for the metadata endpoint. If
+// the prior state is provided, custom metadata keys that are no longer
+// configured are set to nil so that they are removed by a patch request.
+func buildVaultRequestFromModel(ctx context.Context, data, state *KVSecretMetadataModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	vaultRequest := map[string]interface{}{}
+	if !data.MaxVersions.IsNull() && !data.MaxVersions.IsUnknown() {
+		vaultRequest[consts.FieldMaxVersions] = data.MaxVersions.ValueInt64()
+	}
+
+	if !data.CASRequired.IsNull() && !data.CASRequired.IsUnknown() {
+		vaultRequest[consts.FieldCASRequired] = data.CASRequired.ValueBool()
+	}
+
+	if !data.DeleteVersionAfter.IsNull() && !data.DeleteVersionAfter.IsUnknown() {
+		vaultRequest[consts.FieldDeleteVersionAfter] = data.DeleteVersionAfter.ValueInt64()
+	}
+
+	customMetadata := map[string]interface{}{}
+	if state != nil {
+		for k := range state.CustomMetadata.Elements() {
+			customMetadata[k] = nil
+		}
+	}
+
+	if !data.CustomMetadata.IsNull() && !data.CustomMetadata.IsUnknown() {
+		var m map[string]string
+		if d := data.CustomMetadata.ElementsAs(ctx, &m, false); d.HasError() {
+			diags.Append(d...)
+			return nil, diags
+		}
+		for k, v := range m {
+			customMetadata[k] = v
+		}
+	}
+
+	if len(customMetadata) > 0 {
+		vaultRequest[consts.FieldCustomMetadata] = customMetadata
+	}
+
+	return vaultRequest, diags
+}
+
+func makeID(mount, name string) string {
+	return fmt.Sprintf("%s/%s/%s", mount, metadataAffix, name)
+}
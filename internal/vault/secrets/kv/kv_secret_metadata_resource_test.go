@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kv_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccKVSecretMetadata(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("tf-secret")
+	resourceName := "vault_kv_secret_metadata.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKVSecretMetadataConfig(mount, name, `
+  max_versions         = 5
+  cas_required         = true
+  delete_version_after = 3600
+  custom_metadata = {
+    owner = "team-a"
+    env   = "dev"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldID, fmt.Sprintf("%s/metadata/%s", mount, name)),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMount, mount),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldName, name),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMaxVersions, "5"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldCASRequired, "true"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDeleteVersionAfter, "3600"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.owner", "team-a"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.env", "dev"),
+				),
+			},
+			{
+				Config: testAccKVSecretMetadataConfig(mount, name, `
+  max_versions         = 10
+  cas_required         = false
+  delete_version_after = 0
+  custom_metadata = {
+    owner = "team-b"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMaxVersions, "10"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldCASRequired, "false"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDeleteVersionAfter, "0"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.owner", "team-b"),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil),
+		},
+	})
+}
+
+func testAccKVSecretMetadataConfig(mount, name, fields string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path    = "%s"
+  type    = "kv"
+  options = { version = "2" }
+}
+
+resource "vault_kv_secret_metadata" "test" {
+  mount = vault_mount.kvv2.path
+  name  = "%s"
+%s
+}
+`, mount, name, fields)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+func Test_versionState(t *testing.T) {
+	tests := []struct {
+		name string
+		v    map[string]interface{}
+		want string
+	}{
+		{
+			name: "active",
+			v: map[string]interface{}{
+				consts.FieldDeletionTime: "",
+				consts.FieldDestroyed:    false,
+			},
+			want: versionStateActive,
+		},
+		{
+			name: "deleted",
+			v: map[string]interface{}{
+				consts.FieldDeletionTime: time.Now().Add(-time.Hour).Format(time.RFC3339Nano),
+				consts.FieldDestroyed:    false,
+			},
+			want: versionStateDeleted,
+		},
+		{
+			name: "deletion-scheduled",
+			v: map[string]interface{}{
+				consts.FieldDeletionTime: time.Now().Add(time.Hour).Format(time.RFC3339Nano),
+				consts.FieldDestroyed:    false,
+			},
+			want: versionStateActive,
+		},
+		{
+			name: "invalid-deletion-time",
+			v: map[string]interface{}{
+				consts.FieldDeletionTime: "invalid",
+				consts.FieldDestroyed:    false,
+			},
+			want: versionStateDeleted,
+		},
+		{
+			name: "destroyed",
+			v: map[string]interface{}{
+				consts.FieldDeletionTime: time.Now().Add(-time.Hour).Format(time.RFC3339Nano),
+				consts.FieldDestroyed:    true,
+			},
+			want: versionStateDestroyed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := versionState(tt.v); got != tt.want {
+				t.Errorf("versionState() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kv
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+const (
+	versionStateActive    = "active"
+	versionStateDeleted   = "deleted"
+	versionStateDestroyed = "destroyed"
+)
+
+// versionStateEndpoints maps the desired version state to the KV-V2 endpoint
+// that transitions a version into that state.
+var versionStateEndpoints = map[string]string{
+	versionStateActive:    "undelete",
+	versionStateDeleted:   "delete",
+	versionStateDestroyed: "destroy",
+}
+
+// Ensure the implementation satisfies the resource.ResourceWithConfigure interface
+var _ resource.ResourceWithConfigure = &KVSecretVersionStateResource{}
+
+// NewKVSecretVersionStateResource returns the implementation for this resource to be
+// imported by the Terraform Plugin Framework provider
+func NewKVSecretVersionStateResource() resource.Resource { return &KVSecretVersionStateResource{} }
+
+// KVSecretVersionStateResource implements the methods that define this resource
+type KVSecretVersionStateResource struct {
+	base.ResourceWithConfigure
+}
+
+// KVSecretVersionStateModel describes the Terraform resource data model to match the
+// resource schema.
+type KVSecretVersionStateModel struct {
+	base.BaseModelLegacy
+
+	Mount    types.String `tfsdk:"mount"`
+	Name     types.String `tfsdk:"name"`
+	Versions types.Set    `tfsdk:"versions"`
+	State    types.String `tfsdk:"state"`
+}
+
+func (r *KVSecretVersionStateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kv_secret_version_state"
+}
+
+func (r *KVSecretVersionStateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the KV-V2 engine is mounted.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldName: schema.StringAttribute{
+				MarkdownDescription: "Full name of the secret. For a nested secret, the name is the nested path " +
+					"excluding the mount and data prefix.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldVersions: schema.SetAttribute{
+				MarkdownDescription: "The versions of the secret to manage the state of.",
+				ElementType:         types.Int64Type,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			consts.FieldState: schema.StringAttribute{
+				MarkdownDescription: "The state of the versions. One of `active` to undelete the versions, " +
+					"`deleted` to soft delete the versions, or `destroyed` to permanently destroy the versions.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(versionStateActive, versionStateDeleted, versionStateDestroyed),
+				},
+			},
+		},
+		MarkdownDescription: "Manage the state of specific versions of a KV-V2 secret.",
+	}
+	base.MustAddLegacyBaseSchema(&resp.Schema)
+}
+
+// Create is called during the terraform apply command.
+//
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/create
+func (r *KVSecretVersionStateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KVSecretVersionStateModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, &data, errutil.VaultCreateErr)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called during the terraform apply, terraform plan, and terraform
+// refresh commands.
+//
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/read
+func (r *KVSecretVersionStateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data KVSecretVersionStateModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	metadataPath := fmt.Sprintf("%s/%s/%s", data.Mount.ValueString(), metadataAffix, data.Name.ValueString())
+	readResp, err := cli.Logical().ReadWithContext(ctx, metadataPath)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+	if readResp == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	versions, diags := versionsFromModel(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vaultVersions, _ := readResp.Data[consts.FieldVersions].(map[string]interface{})
+
+	// report the state of the first version that has drifted so that the
+	// desired state is applied again
+	for _, version := range versions {
+		v, ok := vaultVersions[strconv.FormatInt(version, 10)].(map[string]interface{})
+		if !ok {
+			// versions that were removed from the metadata, e.g. by
+			// max_versions, can no longer be managed
+			continue
+		}
+
+		if state := versionState(v); state != data.State.ValueString() {
+			data.State = types.StringValue(state)
+			break
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is called during the terraform apply command
+//
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/update
+func (r *KVSecretVersionStateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state KVSecretVersionStateModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.State.ValueString() == versionStateDestroyed && data.State.ValueString() != versionStateDestroyed {
+		resp.Diagnostics.AddError(
+			"Invalid state transition",
+			"Destroyed versions are permanently removed and can not be restored",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, &data, errutil.VaultUpdateErr)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called during the terraform apply command
+//
+// The state of the versions is left as is, the resource is only removed from
+// the Terraform state.
+//
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/delete
+func (r *KVSecretVersionStateResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// write transitions the versions to the desired state.
+func (r *KVSecretVersionStateResource) write(ctx context.Context, data *KVSecretVersionStateModel, errFunc func(error) (string, string)) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		diags.AddError(errutil.ClientConfigureErr(err))
+		return diags
+	}
+
+	versions, d := versionsFromModel(ctx, data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	endpoint := versionStateEndpoints[data.State.ValueString()]
+	path := fmt.Sprintf("%s/%s/%s", data.Mount.ValueString(), endpoint, data.Name.ValueString())
+	if _, err := cli.Logical().WriteWithContext(ctx, path, map[string]interface{}{
+		consts.FieldVersions: versions,
+	}); err != nil {
+		diags.AddError(errFunc(err))
+		return diags
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", data.Mount.ValueString(), metadataAffix, data.Name.ValueString()))
+
+	return diags
+}
+
+// versionsFromModel returns the sorted versions from the model.
+func versionsFromModel(ctx context.Context, data *KVSecretVersionStateModel) ([]int64, diag.Diagnostics) {
+	var versions []int64
+	diags := data.Versions.ElementsAs(ctx, &versions, false)
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+
+	return versions, diags
+}
+
+// versionState returns the state of a version from the secret's metadata.
+// A version with a deletion_time in the future, as set by delete_version_after,
+// is still active until that time has passed.
+func versionState(v map[string]interface{}) string {
+	if destroyed, _ := v[consts.FieldDestroyed].(bool); destroyed {
+		return versionStateDestroyed
+	}
+
+	if deletionTime, _ := v[consts.FieldDeletionTime].(string); deletionTime != "" {
+		t, err := time.Parse(time.RFC3339Nano, deletionTime)
+		if err != nil || !t.After(time.Now()) {
+			return versionStateDeleted
+		}
+	}
+
+	return versionStateActive
+}
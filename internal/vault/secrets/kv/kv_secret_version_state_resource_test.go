@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kv_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccKVSecretVersionState(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("tf-secret")
+	resourceName := "vault_kv_secret_version_state.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKVSecretVersionStateConfig(mount, name, "deleted"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldID, fmt.Sprintf("%s/metadata/%s", mount, name)),
+					resource.TestCheckResourceAttr(resourceName, "versions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldState, "deleted"),
+				),
+			},
+			{
+				Config: testAccKVSecretVersionStateConfig(mount, name, "active"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldState, "active"),
+				),
+			},
+			{
+				Config: testAccKVSecretVersionStateConfig(mount, name, "destroyed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldState, "destroyed"),
+				),
+			},
+			{
+				Config:      testAccKVSecretVersionStateConfig(mount, name, "active"),
+				ExpectError: regexp.MustCompile("Invalid state transition"),
+			},
+		},
+	})
+}
+
+func testAccKVSecretVersionStateConfig(mount, name, state string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path    = "%s"
+  type    = "kv"
+  options = { version = "2" }
+}
+
+resource "vault_kv_secret_v2" "test" {
+  mount     = vault_mount.kvv2.path
+  name      = "%s"
+  data_json = jsonencode({ foo = "bar" })
+  # the versions are managed by vault_kv_secret_version_state
+  disable_read = true
+}
+
+resource "vault_kv_secret_version_state" "test" {
+  mount    = vault_mount.kvv2.path
+  name     = vault_kv_secret_v2.test.name
+  versions = [1]
+  state    = "%s"
+}
+`, mount, name, state)
+}
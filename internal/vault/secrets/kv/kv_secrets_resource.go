@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kv
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+// Ensure the implementation satisfies the resource.ResourceWithConfigure interface
+var _ resource.ResourceWithConfigure = &KVSecretsResource{}
+
+// NewKVSecretsResource returns the implementation for this resource to be
+// imported by the Terraform Plugin Framework provider
+func NewKVSecretsResource() resource.Resource { return &KVSecretsResource{} }
+
+// KVSecretsResource implements the methods that define this resource
+type KVSecretsResource struct {
+	base.ResourceWithConfigure
+}
+
+// KVSecretsModel describes the Terraform resource data model to match the
+// resource schema.
+type KVSecretsModel struct {
+	base.BaseModelLegacy
+
+	Mount   types.String `tfsdk:"mount"`
+	Prefix  types.String `tfsdk:"prefix"`
+	Secrets types.Map    `tfsdk:"secrets"`
+}
+
+func (r *KVSecretsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kv_secrets"
+}
+
+func (r *KVSecretsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the KV-V2 engine is mounted.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldPrefix: schema.StringAttribute{
+				MarkdownDescription: "Common path prefix of the secrets, excluding the mount and data prefix.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldSecrets: schema.MapAttribute{
+				MarkdownDescription: "Map of secret names, relative to the prefix, to their JSON-encoded data. " +
+					"Only the secrets that changed are written.",
+				ElementType: types.StringType,
+				Required:    true,
+				Sensitive:   true,
+			},
+		},
+		MarkdownDescription: "Manage multiple KV-V2 secrets under a common prefix.",
+	}
+	base.MustAddLegacyBaseSchema(&resp.Schema)
+}
+
+// Create is called during the terraform apply command.
+//
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/create
+func (r *KVSecretsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KVSecretsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.sync(ctx, &data, nil, errutil.VaultCreateErr)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(r.path(&data, ""))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called during the terraform apply, terraform plan, and terraform
+// refresh commands.
+//
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/read
+func (r *KVSecretsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data KVSecretsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	var secrets map[string]string
+	resp.Diagnostics.Append(data.Secrets.ElementsAs(ctx, &secrets, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, current := range secrets {
+		readResp, err := cli.Logical().ReadWithContext(ctx, r.path(&data, name))
+		if err != nil {
+			resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+			return
+		}
+
+		// secrets that were deleted outside of Terraform are dropped so
+		// that they are written again
+		var secretData map[string]interface{}
+		if readResp != nil {
+			secretData, _ = readResp.Data[consts.FieldData].(map[string]interface{})
+		}
+		if secretData == nil {
+			delete(secrets, name)
+			continue
+		}
+
+		// the data in the state is kept if it is equal to the data in
+		// Vault, so that a different formatting does not cause a diff
+		if equal, _ := jsonEqual(current, secretData); equal {
+			continue
+		}
+
+		b, err := json.Marshal(secretData)
+		if err != nil {
+			resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+			return
+		}
+		secrets[name] = string(b)
+	}
+
+	m, diags := types.MapValueFrom(ctx, types.StringType, secrets)
+	resp.Diagnostics.Append(diags...)
+	data.Secrets = m
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is called during the terraform apply command
+//
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/update
+func (r *KVSecretsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state KVSecretsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.sync(ctx, &data, &state, errutil.VaultUpdateErr)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(r.path(&data, ""))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called during the terraform apply command
+//
+// The latest version of each secret is deleted, the versions can be
+// undeleted.
+//
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/delete
+func (r *KVSecretsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data KVSecretsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	for name := range data.Secrets.Elements() {
+		if err := r.delete(ctx, cli, &data, name); err != nil {
+			resp.Diagnostics.AddError(errutil.VaultDeleteErr(err))
+			return
+		}
+	}
+}
+
+// sync writes the secrets that are new or changed compared to the prior state
+// and deletes the secrets that were removed. All secrets are written if there
+// is no prior state.
+func (r *KVSecretsResource) sync(ctx context.Context, data, state *KVSecretsModel, errFunc func(error) (string, string)) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	cli, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		diags.AddError(errutil.ClientConfigureErr(err))
+		return diags
+	}
+
+	var planned, prior map[string]string
+	diags.Append(data.Secrets.ElementsAs(ctx, &planned, false)...)
+	if state != nil {
+		diags.Append(state.Secrets.ElementsAs(ctx, &prior, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	for name, v := range planned {
+		if old, ok := prior[name]; ok && old == v {
+			continue
+		}
+
+		var secretData map[string]interface{}
+		if err := json.Unmarshal([]byte(v), &secretData); err != nil {
+			diags.AddError(
+				"Invalid secret data",
+				fmt.Sprintf("The data of secret %q is not a valid JSON object: %s", name, err),
+			)
+			return diags
+		}
+
+		if _, err := cli.Logical().WriteWithContext(ctx, r.path(data, name), map[string]interface{}{
+			consts.FieldData: secretData,
+		}); err != nil {
+			diags.AddError(errFunc(err))
+			return diags
+		}
+	}
+
+	for name := range prior {
+		if _, ok := planned[name]; ok {
+			continue
+		}
+
+		if err := r.delete(ctx, cli, data, name); err != nil {
+			diags.AddError(errFunc(err))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// delete deletes the latest version of the secret, secrets that no longer
+// exist are ignored.
+func (r *KVSecretsResource) delete(ctx context.Context, cli *api.Client, data *KVSecretsModel, name string) error {
+	if _, err := cli.Logical().DeleteWithContext(ctx, r.path(data, name)); err != nil && !util.Is404(err) {
+		return err
+	}
+
+	return nil
+}
+
+// path returns the data path of the named secret under the prefix.
+func (r *KVSecretsResource) path(data *KVSecretsModel, name string) string {
+	parts := []string{data.Mount.ValueString(), dataAffix}
+	if prefix := strings.Trim(data.Prefix.ValueString(), "/"); prefix != "" {
+		parts = append(parts, prefix)
+	}
+	if name != "" {
+		parts = append(parts, name)
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// jsonEqual returns true if the JSON-encoded string holds the same data.
+func jsonEqual(s string, data map[string]interface{}) (bool, error) {
+	var decoded map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &decoded); err != nil {
+		return false, err
+	}
+
+	// re-encode the data so that numbers are compared the same way
+	b, err := json.Marshal(data)
+	if err != nil {
+		return false, err
+	}
+
+	var other map[string]interface{}
+	if err := json.Unmarshal(b, &other); err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(decoded, other), nil
+}
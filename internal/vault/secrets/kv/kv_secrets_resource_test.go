@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kv_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccKVSecrets(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	resourceName := "vault_kv_secrets.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKVSecretsConfig(mount, `
+    db  = jsonencode({ password = "one" })
+    api = jsonencode({ token = "one" })`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldID, fmt.Sprintf("%s/data/app", mount)),
+					resource.TestCheckResourceAttr(resourceName, "secrets.%", "2"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_v2.db", "data.password", "one"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_v2.db", consts.FieldVersion, "1"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_v2.api", "data.token", "one"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_v2.api", consts.FieldVersion, "1"),
+				),
+			},
+			{
+				// only the changed secret is written
+				Config: testAccKVSecretsConfig(mount, `
+    db  = jsonencode({ password = "two" })
+    api = jsonencode({ token = "one" })`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secrets.%", "2"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_v2.db", "data.password", "two"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_v2.db", consts.FieldVersion, "2"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_v2.api", consts.FieldVersion, "1"),
+				),
+			},
+			{
+				Config: testAccKVSecretsConfig(mount, `
+    db  = "not json"
+    api = jsonencode({ token = "one" })`),
+				ExpectError: regexp.MustCompile(`The data of secret "db" is not a valid JSON object`),
+			},
+		},
+	})
+}
+
+func testAccKVSecretsConfig(mount, secrets string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path    = "%s"
+  type    = "kv"
+  options = { version = "2" }
+}
+
+resource "vault_kv_secrets" "test" {
+  mount  = vault_mount.kvv2.path
+  prefix = "app"
+  secrets = {
+%s
+  }
+}
+
+data "vault_kv_secret_v2" "db" {
+  mount      = vault_mount.kvv2.path
+  name       = "app/db"
+  depends_on = [vault_kv_secrets.test]
+}
+
+data "vault_kv_secret_v2" "api" {
+  mount      = vault_mount.kvv2.path
+  name       = "app/api"
+  depends_on = [vault_kv_secrets.test]
+}
+`, mount, secrets)
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralsys
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+const (
+	randomBytesDefaultBytes  = 32
+	randomBytesDefaultFormat = "base64"
+	randomBytesDefaultSource = "platform"
+)
+
+// Ensure the implementation satisfies the ephemeral.EphemeralResource interface
+var _ ephemeral.EphemeralResource = &RandomBytesEphemeralResource{}
+
+// NewRandomBytesEphemeralResource returns the implementation for this resource to be
+// imported by the Terraform Plugin Framework provider
+var NewRandomBytesEphemeralResource = func() ephemeral.EphemeralResource {
+	return &RandomBytesEphemeralResource{}
+}
+
+// RandomBytesEphemeralResource implements the methods that define this resource
+type RandomBytesEphemeralResource struct {
+	base.EphemeralResourceWithConfigure
+}
+
+// RandomBytesModel describes the Terraform resource data model to match the
+// resource schema.
+type RandomBytesModel struct {
+	// common fields to all ephemeral resources
+	base.BaseModelEphemeral
+
+	// fields specific to this resource
+	Bytes  types.Int64  `tfsdk:"bytes"`
+	Format types.String `tfsdk:"format"`
+	Source types.String `tfsdk:"source"`
+
+	// computed fields
+	RandomBytes types.String `tfsdk:"random_bytes"`
+}
+
+// Schema defines this resource's schema which is the data that is available in
+// the resource's configuration, plan, and state
+func (r *RandomBytesEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldBytes: schema.Int64Attribute{
+				MarkdownDescription: "Number of random bytes to generate. Defaults to 32.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			consts.FieldFormat: schema.StringAttribute{
+				MarkdownDescription: "Output encoding of the random bytes, either `hex` or `base64`. Defaults to `base64`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("hex", "base64"),
+				},
+			},
+			consts.FieldSource: schema.StringAttribute{
+				MarkdownDescription: "Source of the random bytes, `platform` uses the platform entropy source, " +
+					"`seal` the entropy source of the seal, `all` mixes both. Defaults to `platform`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("platform", "seal", "all"),
+				},
+			},
+			consts.FieldRandomBytes: schema.StringAttribute{
+				MarkdownDescription: "The generated random bytes in the requested format.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+		MarkdownDescription: "Provides an ephemeral resource to generate random bytes with Vault.",
+	}
+
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+}
+
+// Metadata sets the full name for this resource
+func (r *RandomBytesEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_random_bytes"
+}
+
+// Open generates new random bytes. Random bytes are not leased, so the
+// resource does not implement Renew or Close.
+func (r *RandomBytesEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data RandomBytesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	bytes := int64(randomBytesDefaultBytes)
+	if !data.Bytes.IsNull() {
+		bytes = data.Bytes.ValueInt64()
+	}
+
+	format := randomBytesDefaultFormat
+	if !data.Format.IsNull() {
+		format = data.Format.ValueString()
+	}
+
+	source := randomBytesDefaultSource
+	if !data.Source.IsNull() {
+		source = data.Source.ValueString()
+	}
+
+	reqData := map[string]interface{}{
+		consts.FieldBytes:  bytes,
+		consts.FieldFormat: format,
+	}
+
+	path := r.path(source)
+
+	log.Printf("[DEBUG] Generating random bytes at %q", path)
+	secret, err := c.Logical().WriteWithContext(ctx, path, reqData)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+		return
+	}
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+	log.Printf("[DEBUG] Generated random bytes at %q", path)
+
+	randomBytes, ok := secret.Data[consts.FieldRandomBytes].(string)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid response from Vault",
+			fmt.Sprintf("%s field not found or not a string in Vault response", consts.FieldRandomBytes),
+		)
+		return
+	}
+	data.RandomBytes = types.StringValue(randomBytes)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *RandomBytesEphemeralResource) path(source string) string {
+	return fmt.Sprintf("sys/tools/random/%s", source)
+}
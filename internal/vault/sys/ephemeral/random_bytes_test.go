@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeralsys_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+// TestAccRandomBytes confirms that random bytes generated by Vault are set
+// in the ephemeral resource
+func TestAccRandomBytes(t *testing.T) {
+	testutil.SkipTestAcc(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testRandomBytesConfig(""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					// 32 bytes are 44 base64 characters
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("random_bytes"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^[A-Za-z0-9+/]{43}=$`))),
+				},
+			},
+			{
+				Config: testRandomBytesConfig(`
+  bytes  = 16
+  format = "hex"`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("random_bytes"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^[0-9a-f]{32}$`))),
+				},
+			},
+		},
+	})
+}
+
+func testRandomBytesConfig(extra string) string {
+	return fmt.Sprintf(`
+ephemeral "vault_random_bytes" "test" {%s
+}
+
+provider "echo" {
+  data = {
+    random_bytes = ephemeral.vault_random_bytes.test.random_bytes
+  }
+}
+
+resource "echo" "test" {}
+`, extra)
+}
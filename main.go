@@ -7,8 +7,10 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 	"github.com/hashicorp/terraform-provider-vault/vault"
 )
 
@@ -37,6 +39,14 @@ func main() {
 		serveOpts...,
 	)
 
+	// revoke the child tokens that were requested to be revoked on
+	// shutdown, see the revoke_child_token provider argument.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	if err := provider.RevokeChildTokens(ctx); err != nil {
+		log.Printf("[WARN] %s", err)
+	}
+	cancel()
+
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -4,6 +4,7 @@
 package util
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -452,6 +453,64 @@ func RetryWrite(client *api.Client, path string, data map[string]interface{}, re
 		})
 }
 
+// EventualConsistencyRequestOpts returns the RetryRequestOpts used when waiting
+// for a newly created mount or namespace to become visible on every node of a
+// Vault Enterprise cluster, e.g. performance standbys and secondaries. Nodes
+// that have not caught up with the X-Vault-Index required by a client with
+// ReadYourWrites enabled respond with a 412.
+func EventualConsistencyRequestOpts(maxTries uint64) *RetryRequestOpts {
+	return &RetryRequestOpts{
+		MaxTries: maxTries,
+		Delay:    time.Second,
+		StatusCodes: []int{
+			http.StatusNotFound,
+			http.StatusPreconditionFailed,
+		},
+	}
+}
+
+// RetryRead attempts to retry a Logical.ReadWithContext() from Vault for the
+// RetryRequestOpts. An empty response is also retried, since that is what Vault
+// returns for a path that has not been replicated yet.
+func RetryRead(ctx context.Context, client *api.Client, path string, req *RetryRequestOpts) (*api.Secret, error) {
+	if req == nil {
+		req = DefaultRequestOpts()
+	}
+
+	if path == "" {
+		return nil, fmt.Errorf("path is empty")
+	}
+
+	bo := backoff.WithContext(
+		backoff.WithMaxRetries(backoff.NewConstantBackOff(req.Delay), req.MaxTries), ctx)
+
+	var resp *api.Secret
+	err := backoff.RetryNotify(
+		func() error {
+			r, err := client.Logical().ReadWithContext(ctx, path)
+			if err != nil {
+				e := fmt.Errorf("error reading from path %q, err=%w", path, err)
+				if respErr, ok := err.(*api.ResponseError); ok {
+					if req.IsRetryableStatus(respErr.StatusCode) {
+						return e
+					}
+				}
+
+				return backoff.Permanent(e)
+			}
+			if r == nil {
+				return fmt.Errorf("no response from path %q", path)
+			}
+			resp = r
+			return nil
+		}, bo,
+		func(err error, duration time.Duration) {
+			log.Printf("[WARN] Reading from path %q failed, retrying in %s", path, duration)
+		})
+
+	return resp, err
+}
+
 // GetStringSliceFromSecret will return a string slice from the secret data within the provided field name if it exists.
 // The bool return value will be false if the field does not exist or is not a string slice. It will be true if the field
 // exists and was an empty slice.
@@ -4,6 +4,7 @@
 package util
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -872,6 +873,184 @@ func TestRetryWrite(t *testing.T) {
 	}
 }
 
+func TestRetryRead(t *testing.T) {
+	tests := []struct {
+		name         string
+		path         string
+		req          *RetryRequestOpts
+		retryHandler *testutil.TestRetryHandler
+		want         *api.Secret
+		wantErr      bool
+	}{
+		{
+			name: "ok-without-retries",
+			path: "sys/mounts/foo",
+			req: &RetryRequestOpts{
+				MaxTries:    3,
+				Delay:       time.Millisecond * 100,
+				StatusCodes: []int{http.StatusNotFound},
+			},
+			retryHandler: &testutil.TestRetryHandler{
+				OKAtCount: 1,
+				RespData:  []byte(`{"data":{"type":"kv"}}`),
+			},
+			want: &api.Secret{
+				Data: map[string]interface{}{
+					"type": "kv",
+				},
+			},
+		},
+		{
+			name: "ok-with-retries",
+			path: "sys/mounts/foo",
+			req: &RetryRequestOpts{
+				MaxTries:    3,
+				Delay:       time.Millisecond * 100,
+				StatusCodes: []int{http.StatusNotFound},
+			},
+			retryHandler: &testutil.TestRetryHandler{
+				OKAtCount:   3,
+				RetryStatus: http.StatusNotFound,
+				RespData:    []byte(`{"data":{"type":"kv"}}`),
+			},
+			want: &api.Secret{
+				Data: map[string]interface{}{
+					"type": "kv",
+				},
+			},
+		},
+		{
+			name: "err-non-retryable",
+			path: "sys/mounts/foo",
+			req: &RetryRequestOpts{
+				MaxTries:    3,
+				Delay:       time.Millisecond * 100,
+				StatusCodes: []int{http.StatusNotFound},
+			},
+			retryHandler: &testutil.TestRetryHandler{
+				RetryStatus: http.StatusForbidden,
+			},
+			wantErr: true,
+		},
+		{
+			name: "max-retries-exceeded",
+			path: "sys/mounts/foo",
+			req: &RetryRequestOpts{
+				MaxTries:    3,
+				Delay:       time.Millisecond * 100,
+				StatusCodes: []int{http.StatusNotFound, http.StatusPreconditionFailed},
+			},
+			retryHandler: &testutil.TestRetryHandler{
+				RetryStatus: http.StatusPreconditionFailed,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, ln := testutil.TestHTTPServer(t, tt.retryHandler.Handler())
+			defer ln.Close()
+
+			config.Address = fmt.Sprintf("http://%s", ln.Addr())
+			// disable the client's own retries, so that only RetryRead retries
+			config.MaxRetries = 0
+			client, err := api.NewClient(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := RetryRead(context.Background(), client, tt.path, tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RetryRead() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				expectedRequests := 1
+				if tt.req.IsRetryableStatus(tt.retryHandler.RetryStatus) {
+					expectedRequests = int(tt.req.MaxTries) + 1
+				}
+				if expectedRequests != tt.retryHandler.Requests {
+					t.Fatalf("expected %d requests, actual %d",
+						expectedRequests, tt.retryHandler.Requests)
+				}
+			} else if tt.retryHandler.OKAtCount != tt.retryHandler.Requests {
+				t.Fatalf("expected %d requests, actual %d",
+					tt.retryHandler.OKAtCount, tt.retryHandler.Requests)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RetryRead() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryRead_readYourWrites(t *testing.T) {
+	const state = "v1:cid:1:2:"
+
+	var reads int
+	var gotStates []string
+	config, ln := testutil.TestHTTPServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set(api.HeaderIndex, state)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		reads++
+		gotStates = r.Header.Values(api.HeaderIndex)
+		// the node has not caught up with the required state yet
+		if reads == 1 {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"type":"kv"}}`))
+	}))
+	defer ln.Close()
+
+	// disable the client's own retries, so that only RetryRead retries
+	config.MaxRetries = 0
+	config.ReadYourWrites = true
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.Sys().Mount("foo", &api.MountInput{Type: "kv"}); err != nil {
+		t.Fatal(err)
+	}
+
+	req := EventualConsistencyRequestOpts(3)
+	req.Delay = time.Millisecond * 100
+	if _, err := RetryRead(context.Background(), client, "sys/mounts/foo", req); err != nil {
+		t.Fatalf("RetryRead() unexpected error = %v", err)
+	}
+
+	if reads != 2 {
+		t.Fatalf("expected %d reads, actual %d", 2, reads)
+	}
+
+	if !reflect.DeepEqual(gotStates, []string{state}) {
+		t.Fatalf("expected %s header %v, actual %v", api.HeaderIndex, []string{state}, gotStates)
+	}
+}
+
+func TestEventualConsistencyRequestOpts(t *testing.T) {
+	req := EventualConsistencyRequestOpts(3)
+	for _, code := range []int{http.StatusNotFound, http.StatusPreconditionFailed} {
+		if !req.IsRetryableStatus(code) {
+			t.Errorf("expected status %d to be retryable", code)
+		}
+	}
+
+	if req.IsRetryableStatus(http.StatusBadRequest) {
+		t.Errorf("expected status %d to not be retryable", http.StatusBadRequest)
+	}
+}
+
 func TestGetStringSliceFromSecret(t *testing.T) {
 	fieldName := "foo"
 	var testArray [2]string
@@ -4,6 +4,7 @@
 package vault
 
 import (
+	"encoding/base64"
 	"fmt"
 	"maps"
 	"strconv"
@@ -80,3 +81,14 @@ func convertBatchResults(rawResults interface{}) ([]map[string]interface{}, erro
 
 	return batchResults, nil
 }
+
+// encodeTransitBatchItemFields base64 encodes the given fields of a transit
+// batch_input item, so that batch items accept the same raw values as their
+// non-batch counterparts.
+func encodeTransitBatchItemFields(item map[string]interface{}, fields ...string) {
+	for _, f := range fields {
+		if v, ok := item[f].(string); ok {
+			item[f] = base64.StdEncoding.EncodeToString([]byte(v))
+		}
+	}
+}
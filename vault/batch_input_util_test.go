@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestEncodeTransitBatchItemFields(t *testing.T) {
+	item := map[string]interface{}{
+		"plaintext":   "foo",
+		"context":     "bar",
+		"reference":   "baz",
+		"key_version": 1,
+	}
+
+	encodeTransitBatchItemFields(item, "plaintext", "context", "missing")
+
+	expected := map[string]interface{}{
+		"plaintext":   "Zm9v",
+		"context":     "YmFy",
+		"reference":   "baz",
+		"key_version": 1,
+	}
+	if !reflect.DeepEqual(item, expected) {
+		t.Errorf("encodeTransitBatchItemFields() = %v, want %v", item, expected)
+	}
+}
+
+func TestConvertBatchResults(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{
+			"signature":   "vault:v1:abc",
+			"key_version": json.Number("1"),
+		},
+		map[string]interface{}{
+			"valid":     true,
+			"reference": "foo",
+		},
+	}
+
+	got, err := convertBatchResults(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"signature":   "vault:v1:abc",
+			"key_version": json.Number("1"),
+		},
+		{
+			"valid":     "true",
+			"reference": "foo",
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("convertBatchResults() = %v, want %v", got, expected)
+	}
+
+	if _, err := convertBatchResults("invalid"); err == nil {
+		t.Errorf("convertBatchResults() expected an error for a non-list value")
+	}
+}
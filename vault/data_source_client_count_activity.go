@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const clientCountActivityPath = "sys/internal/counters/activity"
+
+// clientCountFields are the per-client-type counts that Vault reports in
+// both the cluster total and in each namespace breakdown.
+var clientCountFields = []string{
+	consts.FieldClients,
+	consts.FieldEntityClients,
+	consts.FieldNonEntityClients,
+	consts.FieldSecretSyncs,
+	consts.FieldAcmeClients,
+}
+
+func clientCountSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.FieldClients: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The total number of clients.",
+		},
+		consts.FieldEntityClients: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of entity clients.",
+		},
+		consts.FieldNonEntityClients: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of non-entity clients.",
+		},
+		consts.FieldSecretSyncs: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of secret sync clients.",
+		},
+		consts.FieldAcmeClients: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of ACME clients.",
+		},
+	}
+}
+
+func clientCountActivityDataSource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		consts.FieldStartTime: {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "Start of the query interval in RFC3339 format. " +
+				"Defaults to the configured billing start time.",
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		consts.FieldEndTime: {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			Description: "End of the query interval in RFC3339 format. " +
+				"Defaults to the end of the previous month.",
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		consts.FieldByNamespace: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Client counts broken down by namespace.",
+			Elem: &schema.Resource{
+				Schema: func() map[string]*schema.Schema {
+					s := clientCountSchema()
+					s[consts.FieldNamespaceID] = &schema.Schema{
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The ID of the namespace.",
+					}
+					s[consts.FieldNamespacePath] = &schema.Schema{
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The path of the namespace.",
+					}
+					return s
+				}(),
+			},
+		},
+		consts.FieldByNamespaceJSON: {
+			Type:     schema.TypeString,
+			Computed: true,
+			// we save the full breakdown as a JSON string in order to
+			// cleanly support the nested mount level counts
+			Description: "The full namespace breakdown, including per-mount counts, as a JSON string.",
+		},
+		consts.FieldMonthsJSON: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The monthly breakdown of client counts as a JSON string.",
+		},
+	}
+
+	for k, v := range clientCountSchema() {
+		fields[k] = v
+	}
+
+	return &schema.Resource{
+		Read:   provider.ReadWrapper(clientCountActivityDataSourceRead),
+		Schema: fields,
+	}
+}
+
+func clientCountActivityDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	params := map[string][]string{}
+	for _, k := range []string{consts.FieldStartTime, consts.FieldEndTime} {
+		if v, ok := d.GetOk(k); ok {
+			params[k] = []string{v.(string)}
+		}
+	}
+
+	path := clientCountActivityPath
+	log.Printf("[DEBUG] Reading client count activity from %q", path)
+	resp, err := client.Logical().ReadWithData(path, params)
+	if err != nil {
+		return fmt.Errorf("error reading client count activity from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read client count activity from %q", path)
+
+	// Vault responds with a 204 when there is no activity in the interval
+	data := map[string]interface{}{}
+	if resp != nil && resp.Data != nil {
+		data = resp.Data
+	}
+
+	for _, k := range []string{consts.FieldStartTime, consts.FieldEndTime} {
+		if v, ok := data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return err
+			}
+		}
+	}
+
+	if total, ok := data["total"].(map[string]interface{}); ok {
+		for k, v := range clientCountsFromResponse(total) {
+			if err := d.Set(k, v); err != nil {
+				return err
+			}
+		}
+	}
+
+	var byNamespace []map[string]interface{}
+	if v, ok := data[consts.FieldByNamespace].([]interface{}); ok {
+		for _, raw := range v {
+			ns, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			m := map[string]interface{}{
+				consts.FieldNamespaceID:   ns[consts.FieldNamespaceID],
+				consts.FieldNamespacePath: ns[consts.FieldNamespacePath],
+			}
+			if counts, ok := ns["counts"].(map[string]interface{}); ok {
+				for k, v := range clientCountsFromResponse(counts) {
+					m[k] = v
+				}
+			}
+			byNamespace = append(byNamespace, m)
+		}
+	}
+	if err := d.Set(consts.FieldByNamespace, byNamespace); err != nil {
+		return err
+	}
+
+	for k, v := range map[string]string{
+		consts.FieldByNamespace: consts.FieldByNamespaceJSON,
+		"months":                consts.FieldMonthsJSON,
+	} {
+		jsonData, err := json.Marshal(data[k])
+		if err != nil {
+			return fmt.Errorf("error marshaling JSON for %q at %q: %s", v, path, err)
+		}
+		if err := d.Set(v, string(jsonData)); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(path)
+
+	return nil
+}
+
+// clientCountsFromResponse converts the json.Number counts returned by Vault
+// into ints that can be stored in the state.
+func clientCountsFromResponse(counts map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(clientCountFields))
+	for _, k := range clientCountFields {
+		var n int64
+		if v, ok := counts[k].(json.Number); ok {
+			n, _ = v.Int64()
+		}
+		result[k] = int(n)
+	}
+
+	return result
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccDataSourceClientCountActivity(t *testing.T) {
+	ds := "data.vault_client_count_activity.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceClientCountActivityConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(ds, consts.FieldStartTime, "2024-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttrSet(ds, consts.FieldEndTime),
+					resource.TestCheckResourceAttrSet(ds, consts.FieldClients),
+					resource.TestCheckResourceAttrSet(ds, consts.FieldEntityClients),
+					resource.TestCheckResourceAttrSet(ds, consts.FieldNonEntityClients),
+					resource.TestCheckResourceAttrSet(ds, consts.FieldByNamespaceJSON),
+					resource.TestCheckResourceAttrSet(ds, consts.FieldMonthsJSON),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceClientCountActivityConfig() string {
+	return `
+data "vault_client_count_activity" "test" {
+  start_time = "2024-01-01T00:00:00Z"
+}
+`
+}
+
+func TestClientCountActivityDataSourceRead(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        map[string]interface{}
+		wantClients int
+		wantNS      int
+	}{
+		{
+			name:   "no-content",
+			status: http.StatusNoContent,
+		},
+		{
+			name:   "activity",
+			status: http.StatusOK,
+			body: map[string]interface{}{
+				"data": map[string]interface{}{
+					consts.FieldStartTime: "2024-01-01T00:00:00Z",
+					consts.FieldEndTime:   "2024-01-31T23:59:59Z",
+					"total": map[string]interface{}{
+						consts.FieldClients: 3,
+					},
+					consts.FieldByNamespace: []interface{}{
+						map[string]interface{}{
+							consts.FieldNamespaceID:   "root",
+							consts.FieldNamespacePath: "",
+							"counts": map[string]interface{}{
+								consts.FieldClients: 3,
+							},
+						},
+					},
+				},
+			},
+			wantClients: 3,
+			wantNS:      1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := testMockVaultMeta(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/"+clientCountActivityPath {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				if tt.body == nil {
+					w.WriteHeader(tt.status)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				json.NewEncoder(w).Encode(tt.body)
+			})
+
+			r := clientCountActivityDataSource()
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+				consts.FieldStartTime: "2024-01-01T00:00:00Z",
+			})
+			if err := r.Read(d, meta); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := d.Get(consts.FieldClients).(int); got != tt.wantClients {
+				t.Errorf("expected %d clients, got %d", tt.wantClients, got)
+			}
+			if got := len(d.Get(consts.FieldByNamespace).([]interface{})); got != tt.wantNS {
+				t.Errorf("expected %d namespaces, got %d", tt.wantNS, got)
+			}
+			if got := d.Get(consts.FieldStartTime).(string); got != "2024-01-01T00:00:00Z" {
+				t.Errorf("expected start_time to be kept, got %q", got)
+			}
+			if d.Id() != clientCountActivityPath {
+				t.Errorf("expected ID %q, got %q", clientCountActivityPath, d.Id())
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func haStatusDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: provider.ReadWrapper(haStatusDataSourceRead),
+		Schema: map[string]*schema.Schema{
+			consts.FieldHAEnabled: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether high availability is enabled on the Vault cluster.",
+			},
+			consts.FieldIsSelf: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the node that served the request is the active node.",
+			},
+			consts.FieldActiveTime: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the current active node became active, in RFC3339 format.",
+			},
+			consts.FieldLeaderAddress: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API address of the active node.",
+			},
+			consts.FieldLeaderClusterAddress: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The cluster address of the active node.",
+			},
+			consts.FieldPerformanceStandby: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the node that served the request is a performance standby.",
+			},
+			consts.FieldRaftCommittedIndex: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The raft committed index, only set when using integrated storage.",
+			},
+			consts.FieldRaftAppliedIndex: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The raft applied index, only set when using integrated storage.",
+			},
+			consts.FieldNodes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The nodes in the HA cluster.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldHostname: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The hostname of the node.",
+						},
+						consts.FieldAPIAddress: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The API address of the node.",
+						},
+						consts.FieldClusterAddress: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The cluster address of the node.",
+						},
+						consts.FieldActiveNode: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the node is the active node.",
+						},
+						consts.FieldLastEcho: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The last time the node echoed to the active node, in RFC3339 format.",
+						},
+						consts.FieldVersion: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Vault version running on the node.",
+						},
+						consts.FieldUpgradeVersion: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The upgrade version of the node, only set when using integrated storage.",
+						},
+						consts.FieldRedundancyZone: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The redundancy zone of the node, only set when using integrated storage.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func haStatusDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	log.Printf("[DEBUG] Reading leader status from Vault")
+	leader, err := client.Sys().Leader()
+	if err != nil {
+		return fmt.Errorf("error reading leader status from Vault: %s", err)
+	}
+
+	log.Printf("[DEBUG] Reading HA status from Vault")
+	haStatus, err := client.Sys().HAStatus()
+	if err != nil {
+		return fmt.Errorf("error reading HA status from Vault: %s", err)
+	}
+
+	var activeTime string
+	if !leader.ActiveTime.IsZero() {
+		activeTime = leader.ActiveTime.Format(time.RFC3339)
+	}
+
+	data := map[string]interface{}{
+		consts.FieldHAEnabled:            leader.HAEnabled,
+		consts.FieldIsSelf:               leader.IsSelf,
+		consts.FieldActiveTime:           activeTime,
+		consts.FieldLeaderAddress:        leader.LeaderAddress,
+		consts.FieldLeaderClusterAddress: leader.LeaderClusterAddress,
+		consts.FieldPerformanceStandby:   leader.PerfStandby,
+		consts.FieldRaftCommittedIndex:   int(leader.RaftCommittedIndex),
+		consts.FieldRaftAppliedIndex:     int(leader.RaftAppliedIndex),
+	}
+
+	nodes := make([]map[string]interface{}, 0, len(haStatus.Nodes))
+	for _, n := range haStatus.Nodes {
+		var lastEcho string
+		if n.LastEcho != nil {
+			lastEcho = n.LastEcho.Format(time.RFC3339)
+		}
+		nodes = append(nodes, map[string]interface{}{
+			consts.FieldHostname:       n.Hostname,
+			consts.FieldAPIAddress:     n.APIAddress,
+			consts.FieldClusterAddress: n.ClusterAddress,
+			consts.FieldActiveNode:     n.ActiveNode,
+			consts.FieldLastEcho:       lastEcho,
+			consts.FieldVersion:        n.Version,
+			consts.FieldUpgradeVersion: n.UpgradeVersion,
+			consts.FieldRedundancyZone: n.RedundancyZone,
+		})
+	}
+	data[consts.FieldNodes] = nodes
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error setting %q for HA status: %w", k, err)
+		}
+	}
+
+	// Single instance data source - defaulting ID to 'default'
+	d.SetId("default")
+
+	return nil
+}
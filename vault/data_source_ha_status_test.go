@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccDataSourceHAStatus(t *testing.T) {
+	ds := "data.vault_ha_status.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceHAStatusConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(ds, consts.FieldHAEnabled),
+					resource.TestCheckResourceAttrSet(ds, consts.FieldIsSelf),
+					resource.TestCheckResourceAttrSet(ds, consts.FieldPerformanceStandby),
+					resource.TestCheckResourceAttrSet(ds, consts.FieldNodes+".#"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceHAStatusConfig() string {
+	return `
+data "vault_ha_status" "test" {}
+`
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+var hashAlgorithms = []string{
+	"sha2-224",
+	"sha2-256",
+	"sha2-384",
+	"sha2-512",
+	"sha3-224",
+	"sha3-256",
+	"sha3-384",
+	"sha3-512",
+}
+
+func hashDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: provider.ReadContextWrapper(readHash),
+		Schema: map[string]*schema.Schema{
+			consts.FieldInput: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The input to hash, base64 encoded by the provider.",
+			},
+			consts.FieldAlgorithm: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "sha2-256",
+				Description:  "The hash algorithm to use.",
+				ValidateFunc: validation.StringInSlice(hashAlgorithms, false),
+			},
+			consts.FieldFormat: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "hex",
+				Description:  "The output encoding of the hash, either hex or base64.",
+				ValidateFunc: validation.StringInSlice([]string{"hex", "base64"}, false),
+			},
+			consts.FieldSum: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The hash of the input.",
+			},
+		},
+	}
+}
+
+func readHash(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := "sys/tools/hash/" + d.Get(consts.FieldAlgorithm).(string)
+	data := map[string]interface{}{
+		consts.FieldInput:  base64.StdEncoding.EncodeToString([]byte(d.Get(consts.FieldInput).(string))),
+		consts.FieldFormat: d.Get(consts.FieldFormat),
+	}
+
+	log.Printf("[DEBUG] Hashing input with %q", path)
+	resp, err := client.Logical().WriteWithContext(ctx, path, data)
+	if err != nil {
+		return diag.Errorf("error hashing input with %q: %s", path, err)
+	}
+	if resp == nil {
+		return diag.Errorf("no response from %q", path)
+	}
+	log.Printf("[DEBUG] Hashed input with %q", path)
+
+	sum, ok := resp.Data[consts.FieldSum].(string)
+	if !ok {
+		return diag.Errorf("%s field not found or not a string in response from %q", consts.FieldSum, path)
+	}
+
+	d.SetId(path)
+	if err := d.Set(consts.FieldSum, sum); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
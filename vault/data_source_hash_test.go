@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccDataSourceHash(t *testing.T) {
+	ds := "data.vault_hash.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceHashConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(ds, consts.FieldAlgorithm, "sha2-256"),
+					resource.TestCheckResourceAttr(ds, consts.FieldFormat, "hex"),
+					resource.TestCheckResourceAttr(ds, consts.FieldSum,
+						"2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"),
+				),
+			},
+			{
+				Config: testDataSourceHashConfig(`
+  algorithm = "sha3-512"
+  format    = "base64"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(ds, consts.FieldSum,
+						"ddUnw2jy7+hI7Pawc6NnZ4AIBenu8rGFfV+YTwNutt+JHXX3LZsVRRjBzViDUobR2po43ro96YtaU+XteKhJdg=="),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceHashConfig(extra string) string {
+	return fmt.Sprintf(`
+data "vault_hash" "test" {
+  input = "hello"%s
+}
+`, extra)
+}
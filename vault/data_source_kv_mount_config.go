@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func kvMountConfigDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: provider.ReadContextWrapper(kvMountConfigDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldMount: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where the KV engine is mounted.",
+			},
+			consts.FieldKVVersion: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the KV engine, 1 or 2.",
+			},
+			consts.FieldMaxVersions: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of versions to keep per key. Only set for KV-V2 mounts.",
+			},
+			consts.FieldCASRequired: {
+				Type:     schema.TypeBool,
+				Computed: true,
+				Description: "If true, all keys require the cas parameter to be set " +
+					"on all write requests. Only set for KV-V2 mounts.",
+			},
+			consts.FieldDeleteVersionAfter: {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Description: "The length of time in seconds before a version is deleted. " +
+					"Only set for KV-V2 mounts.",
+			},
+		},
+	}
+}
+
+func kvMountConfigDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	mount := strings.Trim(d.Get(consts.FieldMount).(string), "/")
+
+	_, version, err := kvPreflightVersionRequest(client, mount)
+	if err != nil {
+		return diag.Errorf("error determining the KV version of %q: %s", mount, err)
+	}
+
+	if err := d.Set(consts.FieldKVVersion, version); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(mount)
+
+	// the config endpoint only exists on KV-V2 mounts, on KV-V1 mounts it
+	// would read a secret named config
+	if version != 2 {
+		return nil
+	}
+
+	path := mount + "/config"
+	log.Printf("[DEBUG] Reading %s from Vault", path)
+	config, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return diag.Errorf("error reading config from Vault: %s", err)
+	}
+	if config == nil {
+		return diag.Errorf("no config found at %q", path)
+	}
+
+	for _, k := range []string{consts.FieldMaxVersions, consts.FieldCASRequired} {
+		if err := d.Set(k, config.Data[k]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// convert delete_version_after to seconds
+	if v, ok := config.Data[consts.FieldDeleteVersionAfter].(string); ok && v != "" {
+		t, err := time.ParseDuration(v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set(consts.FieldDeleteVersionAfter, int(t.Seconds())); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
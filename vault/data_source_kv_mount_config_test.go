@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceKVMountConfig(t *testing.T) {
+	t.Parallel()
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	mountV1 := acctest.RandomWithPrefix("tf-kv")
+
+	resourceName := "data.vault_kv_mount_config.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKVMountConfig(mount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMount, mount),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldKVVersion, "2"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMaxVersions, "5"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldCASRequired, "true"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDeleteVersionAfter, "3700"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "vault_kv_mount_config" "test" {
+  mount = vault_mount.kvv1.path
+}`, kvV1MountConfig(mountV1)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMount, mountV1),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldKVVersion, "1"),
+					resource.TestCheckNoResourceAttr(resourceName, consts.FieldMaxVersions),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKVMountConfig(mount string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_kv_secret_backend_v2" "test" {
+  mount                = vault_mount.kvv2.path
+  max_versions         = 5
+  delete_version_after = 3700
+  cas_required         = true
+}
+
+data "vault_kv_mount_config" "test" {
+  mount      = vault_mount.kvv2.path
+  depends_on = [vault_kv_secret_backend_v2.test]
+}`, kvV2MountConfig(mount))
+}
@@ -69,9 +69,17 @@ func kvSecretDataSourceRead(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.FromErr(err)
 	}
 
+	// KV-V2 secrets are read from the data/ sub-path, reading them here would
+	// silently return no data.
+	if _, v2, err := isKVv2(path, client); err != nil {
+		return diag.Errorf("error determining the KV version of %q: %s", path, err)
+	} else if v2 {
+		return diag.Errorf("%q is on a KV-V2 mount, use the vault_kv_secret_v2 data source instead", path)
+	}
+
 	log.Printf("[DEBUG] Reading secret at %s from Vault", path)
 
-	secret, err := client.Logical().Read(path)
+	secret, err := client.Logical().ReadWithContext(ctx, path)
 	if err != nil {
 		return diag.Errorf("error reading secret %q from Vault: %s", path, err)
 	}
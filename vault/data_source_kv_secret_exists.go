@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func kvSecretExistsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: provider.ReadContextWrapper(kvSecretExistsDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldMount: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where KV-V2 engine is mounted",
+			},
+
+			consts.FieldName: {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "Full name of the secret. For a nested secret, " +
+					"the name is the nested path excluding the mount and data " +
+					"prefix. For example, for a secret at 'kvv2/data/foo/bar/baz', " +
+					"the name is 'foo/bar/baz'",
+			},
+
+			consts.FieldPath: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path of the secret's metadata.",
+			},
+
+			consts.FieldExists: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the secret exists.",
+			},
+
+			consts.FieldCurrentVersion: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The current version of the secret, 0 if the secret does not exist.",
+			},
+		},
+	}
+}
+
+func kvSecretExistsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	mount := d.Get(consts.FieldMount).(string)
+	name := d.Get(consts.FieldName).(string)
+
+	// only the metadata is read, the secret data is never fetched
+	path := getKVV2Path(mount, name, consts.FieldMetadata)
+	if err := d.Set(consts.FieldPath, path); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Reading secret metadata at %q from Vault", path)
+	resp, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return diag.Errorf("error reading secret metadata %q from Vault: %s", path, err)
+	}
+
+	var currentVersion int64
+	if resp != nil {
+		currentVersion, err = kvV2MetadataInt(resp.Data, consts.FieldCurrentVersion)
+		if err != nil {
+			return diag.Errorf("invalid metadata for %q: %s", path, err)
+		}
+	}
+
+	if err := d.Set(consts.FieldExists, resp != nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(consts.FieldCurrentVersion, currentVersion); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(path)
+
+	return nil
+}
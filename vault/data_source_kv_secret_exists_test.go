@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceKVSecretExists(t *testing.T) {
+	t.Parallel()
+	mount := acctest.RandomWithPrefix("tf-kv")
+	name := acctest.RandomWithPrefix("foo")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKVSecretExistsConfig(mount, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_kv_secret_exists.found", consts.FieldPath, fmt.Sprintf("%s/metadata/%s", mount, name)),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_exists.found", consts.FieldExists, "true"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_exists.found", consts.FieldCurrentVersion, "1"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_exists.missing", consts.FieldExists, "false"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_exists.missing", consts.FieldCurrentVersion, "0"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKVSecretExistsConfig(mount, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_kv_secret_v2" "test" {
+  mount = vault_mount.kvv2.path
+  name  = "%s"
+  data_json = jsonencode(
+    {
+      zip = "zap"
+    }
+  )
+}
+
+data "vault_kv_secret_exists" "found" {
+  mount = vault_mount.kvv2.path
+  name  = vault_kv_secret_v2.test.name
+}
+
+data "vault_kv_secret_exists" "missing" {
+  mount = vault_mount.kvv2.path
+  name  = "${vault_kv_secret_v2.test.name}-missing"
+}`, kvV2MountConfig(mount), name)
+}
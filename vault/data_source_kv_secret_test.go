@@ -6,6 +6,7 @@ package vault
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -57,6 +58,27 @@ func TestDataSourceKVSecret(t *testing.T) {
 	})
 }
 
+func TestDataSourceKVSecret_kvV2Mount(t *testing.T) {
+	t.Parallel()
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "vault_kv_secret" "test" {
+  path = "${vault_mount.kvv2.path}/foo"
+}`, kvV2MountConfig(mount)),
+				ExpectError: regexp.MustCompile("is on a KV-V2 mount"),
+			},
+		},
+	})
+}
+
 func testDataSourceKVSecretConfig(mount, name string) string {
 	return fmt.Sprintf(`
 %s
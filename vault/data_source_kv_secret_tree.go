@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func kvSecretTreeDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: provider.ReadContextWrapper(kvSecretTreeDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldMount: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where KV-V2 engine is mounted",
+			},
+
+			consts.FieldPrefix: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path under the mount to list the secrets from, " +
+					"excluding the mount and metadata prefix. Defaults to the root of the mount.",
+			},
+
+			consts.FieldMaxDepth: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "The maximum number of levels to walk below the prefix, " +
+					"0 walks the whole tree.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			consts.FieldFilter: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Glob pattern that the secret paths must match, " +
+					"'*' does not match across '/'.",
+				ValidateFunc: validateKVSecretTreeFilter,
+			},
+
+			consts.FieldPath: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path where the KV-V2 secrets are listed from.",
+			},
+
+			consts.FieldPaths: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Sorted list of the paths of all secrets under the prefix, relative to the mount.",
+			},
+		},
+	}
+}
+
+func kvSecretTreeDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	mount := strings.Trim(d.Get(consts.FieldMount).(string), "/")
+	prefix := strings.Trim(d.Get(consts.FieldPrefix).(string), "/")
+
+	metadataPath := getKVV2Path(mount, prefix, consts.FieldMetadata)
+	if err := d.Set(consts.FieldPath, metadataPath); err != nil {
+		return diag.FromErr(err)
+	}
+
+	paths, err := kvV2ListTree(client, mount, prefix, d.Get(consts.FieldMaxDepth).(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	paths, err = filterKVSecretTreePaths(paths, d.Get(consts.FieldFilter).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(consts.FieldPaths, paths); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(metadataPath)
+
+	return nil
+}
+
+// kvV2ListTree recursively lists the secrets under the prefix and returns their
+// paths relative to the mount. The keys directly under the prefix are at depth
+// 1, a maxDepth of 0 walks the whole tree.
+func kvV2ListTree(client *api.Client, mount, prefix string, maxDepth int) ([]string, error) {
+	var paths []string
+
+	var walk func(dir string, depth int) error
+	walk = func(dir string, depth int) error {
+		listPath := getKVV2Path(mount, dir, consts.FieldMetadata)
+		log.Printf("[DEBUG] Listing secrets at %s from Vault", listPath)
+		resp, err := client.Logical().List(listPath)
+		if err != nil {
+			return fmt.Errorf("error listing from Vault at path %q, err=%s", listPath, err)
+		}
+
+		// nothing to list, e.g. the prefix does not exist or
+		// all secrets below it were deleted while walking
+		if resp == nil {
+			return nil
+		}
+
+		keys, ok := resp.Data["keys"].([]interface{})
+		if !ok {
+			return fmt.Errorf("keys are incorrectly formatted in response from Vault")
+		}
+
+		for _, k := range keys {
+			key, _ := k.(string)
+			p := key
+			if dir != "" {
+				p = dir + "/" + key
+			}
+
+			// keys ending with a slash are folders
+			if strings.HasSuffix(key, "/") {
+				if maxDepth == 0 || depth < maxDepth {
+					if err := walk(strings.TrimSuffix(p, "/"), depth+1); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+
+			paths = append(paths, p)
+		}
+
+		return nil
+	}
+
+	if err := walk(prefix, 1); err != nil {
+		return nil, err
+	}
+
+	sort.Strings(paths)
+
+	return paths, nil
+}
+
+// filterKVSecretTreePaths returns the paths that match the glob pattern, all
+// paths are returned if the pattern is empty.
+func filterKVSecretTreePaths(paths []string, pattern string) ([]string, error) {
+	if pattern == "" {
+		return paths, nil
+	}
+
+	var filtered []string
+	for _, p := range paths {
+		ok, err := path.Match(pattern, p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %q %q, err=%w", consts.FieldFilter, pattern, err)
+		}
+		if ok {
+			filtered = append(filtered, p)
+		}
+	}
+
+	return filtered, nil
+}
+
+func validateKVSecretTreeFilter(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if _, err := path.Match(v, ""); err != nil {
+		return nil, []error{fmt.Errorf("invalid glob pattern %q for %q, err=%w", v, k, err)}
+	}
+
+	return nil, nil
+}
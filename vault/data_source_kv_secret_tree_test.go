@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceKVSecretTree(t *testing.T) {
+	t.Parallel()
+	mount := acctest.RandomWithPrefix("tf-kv")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKVSecretTreeConfig(mount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_kv_secret_tree.all", consts.FieldPath, fmt.Sprintf("%s/metadata/", mount)),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_tree.all", "paths.#", "4"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_tree.all", "paths.0", "app/db"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_tree.all", "paths.1", "app/nested/api"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_tree.all", "paths.2", "app/nested/deeper/token"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_tree.all", "paths.3", "root"),
+
+					resource.TestCheckResourceAttr("data.vault_kv_secret_tree.prefix", consts.FieldPath, fmt.Sprintf("%s/metadata/app", mount)),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_tree.prefix", "paths.#", "3"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_tree.prefix", "paths.0", "app/db"),
+
+					resource.TestCheckResourceAttr("data.vault_kv_secret_tree.depth", "paths.#", "2"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_tree.depth", "paths.0", "app/db"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_tree.depth", "paths.1", "app/nested/api"),
+
+					resource.TestCheckResourceAttr("data.vault_kv_secret_tree.filter", "paths.#", "1"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_tree.filter", "paths.0", "app/nested/api"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+data "vault_kv_secret_tree" "invalid" {
+  mount  = "%s"
+  filter = "[app"
+}`, mount),
+				ExpectError: regexp.MustCompile(`invalid glob pattern`),
+			},
+		},
+	})
+}
+
+func testDataSourceKVSecretTreeConfig(mount string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_kv_secret_v2" "test" {
+  for_each = toset(["root", "app/db", "app/nested/api", "app/nested/deeper/token"])
+  mount    = vault_mount.kvv2.path
+  name     = each.key
+  data_json = jsonencode(
+    {
+      zip = "zap"
+    }
+  )
+}
+
+data "vault_kv_secret_tree" "all" {
+  mount      = vault_mount.kvv2.path
+  depends_on = [vault_kv_secret_v2.test]
+}
+
+data "vault_kv_secret_tree" "prefix" {
+  mount      = vault_mount.kvv2.path
+  prefix     = "app"
+  depends_on = [vault_kv_secret_v2.test]
+}
+
+data "vault_kv_secret_tree" "depth" {
+  mount      = vault_mount.kvv2.path
+  prefix     = "app"
+  max_depth  = 2
+  depends_on = [vault_kv_secret_v2.test]
+}
+
+data "vault_kv_secret_tree" "filter" {
+  mount      = vault_mount.kvv2.path
+  filter     = "app/*/api"
+  depends_on = [vault_kv_secret_v2.test]
+}`, kvV2MountConfig(mount))
+}
@@ -5,6 +5,7 @@ package vault
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"log"
 	"strconv"
@@ -64,6 +65,14 @@ func kvSecretV2DataSource() *schema.Resource {
 				Sensitive:   true,
 			},
 
+			consts.FieldDataBase64: {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: "Map of the secret data with each value base64-encoded, " +
+					"non-string values are serialized as JSON before being encoded.",
+				Sensitive: true,
+			},
+
 			"created_time": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -73,7 +82,7 @@ func kvSecretV2DataSource() *schema.Resource {
 			"custom_metadata": {
 				Type:        schema.TypeMap,
 				Computed:    true,
-				Description: "Custom metadata for the secret",
+				Description: "Custom metadata for the secret, empty if the secret has no custom metadata.",
 			},
 
 			"deletion_time": {
@@ -91,7 +100,7 @@ func kvSecretV2DataSource() *schema.Resource {
 	}
 }
 
-func kvSecretV2DataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func kvSecretV2DataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, e := provider.GetClient(d, meta)
 	if e != nil {
 		return diag.FromErr(e)
@@ -113,10 +122,10 @@ func kvSecretV2DataSourceRead(_ context.Context, d *schema.ResourceData, meta in
 		data := map[string][]string{
 			"version": {strconv.Itoa(v.(int))},
 		}
-		secret, err = client.Logical().ReadWithData(path, data)
+		secret, err = client.Logical().ReadWithDataWithContext(ctx, path, data)
 		log.Printf("[DEBUG] Reading secret at %q (version %d) from Vault", path, v)
 	} else {
-		secret, err = client.Logical().Read(path)
+		secret, err = client.Logical().ReadWithContext(ctx, path)
 		log.Printf("[DEBUG] Reading secret at %q (latest version) from Vault", path)
 	}
 
@@ -142,6 +151,10 @@ func kvSecretV2DataSourceRead(_ context.Context, d *schema.ResourceData, meta in
 		if err := d.Set(consts.FieldData, serializeDataMapToString(v)); err != nil {
 			return diag.FromErr(err)
 		}
+
+		if err := d.Set(consts.FieldDataBase64, encodeDataMapToBase64(v)); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	if v, ok := secret.Data["metadata"]; ok {
@@ -171,12 +184,11 @@ func kvSecretV2DataSourceRead(_ context.Context, d *schema.ResourceData, meta in
 			}
 		}
 
-		if customMetadata, ok := metadata["custom_metadata"]; ok && customMetadata != nil {
-			if v, ok := customMetadata.(map[string]interface{}); ok {
-				if err := d.Set("custom_metadata", serializeDataMapToString(v)); err != nil {
-					return diag.FromErr(err)
-				}
-			}
+		// custom_metadata is always set, so that it can be used in expressions,
+		// e.g. with lookup(), even if the secret has no custom metadata.
+		customMetadata, _ := metadata["custom_metadata"].(map[string]interface{})
+		if err := d.Set("custom_metadata", serializeDataMapToString(customMetadata)); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -184,3 +196,14 @@ func kvSecretV2DataSourceRead(_ context.Context, d *schema.ResourceData, meta in
 
 	return nil
 }
+
+// encodeDataMapToBase64 returns the secret data with each value base64-encoded,
+// so that it can be passed to arguments that expect base64-encoded content
+// without going through Terraform's string handling.
+func encodeDataMapToBase64(data map[string]interface{}) map[string]string {
+	dataMap := map[string]string{}
+	for k, v := range serializeDataMapToString(data) {
+		dataMap[k] = base64.StdEncoding.EncodeToString([]byte(v))
+	}
+	return dataMap
+}
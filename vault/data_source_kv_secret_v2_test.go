@@ -41,6 +41,10 @@ func TestDataSourceKVV2Secret(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "data.foo", "bar"),
 					resource.TestCheckResourceAttr(resourceName, "data.test", "false"),
 					resource.TestCheckResourceAttr(resourceName, "data.baz", "{\"riff\":\"raff\"}"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "data_base64.%", "4"),
+					resource.TestCheckResourceAttr(resourceName, "data_base64.zip", "emFw"),
+					resource.TestCheckResourceAttr(resourceName, "data_base64.baz", "eyJyaWZmIjoicmFmZiJ9"),
 					testutil.CheckJSONData(resourceName, consts.FieldDataJSON, expectedSubkeys),
 				),
 			},
@@ -56,6 +60,8 @@ func TestDataSourceKVV2Secret(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "data.foo", "bar"),
 					resource.TestCheckResourceAttr(resourceName, "data.test", "false"),
 					resource.TestCheckResourceAttr(resourceName, "data.baz", "{\"riff\":\"raff\"}"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.owner", "team-a"),
 					testutil.CheckJSONData(resourceName, consts.FieldDataJSON, expectedSubkeys),
 				),
 			},
@@ -163,12 +169,18 @@ resource "vault_kv_secret_v2" "test" {
         }
   }
   )
+  custom_metadata {
+    data = {
+      owner = "team-a"
+    }
+  }
 }
 
 data "vault_kv_secret_v2" "test" {
-  mount = vault_mount.kvv2.path
-  name  = vault_kv_secret_v2.test.name
-  version = 1
+  mount      = vault_mount.kvv2.path
+  name       = vault_kv_secret_v2.test.name
+  version    = 1
+  depends_on = [vault_kv_secret_v2.test]
 }`, kvV2MountConfig(mount), name)
 }
 
@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func kvSecretVersionsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: provider.ReadContextWrapper(kvSecretVersionsDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldMount: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where KV-V2 engine is mounted",
+			},
+
+			consts.FieldName: {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "Full name of the secret. For a nested secret, " +
+					"the name is the nested path excluding the mount and data " +
+					"prefix. For example, for a secret at 'kvv2/data/foo/bar/baz', " +
+					"the name is 'foo/bar/baz'",
+			},
+
+			consts.FieldVersions: {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeInt},
+				Description:  "The versions of the secret to read.",
+				ExactlyOneOf: []string{consts.FieldVersions, consts.FieldLast},
+			},
+
+			consts.FieldLast: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The number of latest versions of the secret to read.",
+				ValidateFunc: validation.IntAtLeast(1),
+				ExactlyOneOf: []string{consts.FieldVersions, consts.FieldLast},
+			},
+
+			consts.FieldPath: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path where the KVV2 secret is written.",
+			},
+
+			consts.FieldCurrentVersion: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The current version of the secret.",
+			},
+
+			consts.FieldSecrets: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The requested versions of the secret, sorted from the newest to the oldest version.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldVersion: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Version of the secret.",
+						},
+						consts.FieldDataJSON: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "JSON-encoded secret data read from Vault.",
+							Sensitive:   true,
+						},
+						consts.FieldData: {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Map of strings read from Vault.",
+							Sensitive:   true,
+						},
+						consts.FieldCreatedTime: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time at which the version was created.",
+						},
+						consts.FieldDeletionTime: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Deletion time of the version.",
+						},
+						consts.FieldDestroyed: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the version has been destroyed.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func kvSecretVersionsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	mount := d.Get(consts.FieldMount).(string)
+	name := d.Get(consts.FieldName).(string)
+
+	path := getKVV2Path(mount, name, consts.FieldData)
+	if err := d.Set(consts.FieldPath, path); err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadataPath := getKVV2Path(mount, name, consts.FieldMetadata)
+	log.Printf("[DEBUG] Reading secret metadata at %q from Vault", metadataPath)
+	metadata, err := client.Logical().ReadWithContext(ctx, metadataPath)
+	if err != nil {
+		return diag.Errorf("error reading secret metadata %q from Vault: %s", metadataPath, err)
+	}
+	if metadata == nil {
+		return diag.Errorf("no secret found at %q", path)
+	}
+
+	currentVersion, err := kvV2MetadataInt(metadata.Data, consts.FieldCurrentVersion)
+	if err != nil {
+		return diag.Errorf("invalid metadata for %q: %s", metadataPath, err)
+	}
+
+	available, _ := metadata.Data[consts.FieldVersions].(map[string]interface{})
+	versions, err := kvSecretVersionsToRead(d, available)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var secrets []map[string]interface{}
+	for _, version := range versions {
+		secret, err := readKVV2SecretVersion(ctx, client, path, version)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		secrets = append(secrets, secret)
+	}
+
+	if err := d.Set(consts.FieldCurrentVersion, currentVersion); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(consts.FieldSecrets, secrets); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(path)
+
+	return nil
+}
+
+// kvSecretVersionsToRead returns the versions to read sorted from the newest to
+// the oldest, either the configured versions or the last N versions that are
+// available in the secret's metadata.
+func kvSecretVersionsToRead(d *schema.ResourceData, available map[string]interface{}) ([]int, error) {
+	var versions []int
+	if v, ok := d.GetOk(consts.FieldVersions); ok {
+		for _, version := range v.(*schema.Set).List() {
+			version := version.(int)
+			if _, ok := available[strconv.Itoa(version)]; !ok {
+				return nil, fmt.Errorf("version %d of the secret does not exist", version)
+			}
+			versions = append(versions, version)
+		}
+	} else {
+		for k := range available {
+			version, err := strconv.Atoi(k)
+			if err != nil {
+				return nil, fmt.Errorf("invalid version %q in the secret's metadata", k)
+			}
+			versions = append(versions, version)
+		}
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(versions)))
+
+	if last, ok := d.GetOk(consts.FieldLast); ok && len(versions) > last.(int) {
+		versions = versions[:last.(int)]
+	}
+
+	return versions, nil
+}
+
+// readKVV2SecretVersion reads a version of the secret. The data of deleted or
+// destroyed versions is empty.
+func readKVV2SecretVersion(ctx context.Context, client *api.Client, path string, version int) (map[string]interface{}, error) {
+	log.Printf("[DEBUG] Reading secret at %q (version %d) from Vault", path, version)
+	secret, err := client.Logical().ReadWithDataWithContext(ctx, path, map[string][]string{
+		consts.FieldVersion: {strconv.Itoa(version)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading secret %q (version %d) from Vault: %s", path, version, err)
+	}
+
+	result := map[string]interface{}{
+		consts.FieldVersion:  version,
+		consts.FieldDataJSON: "",
+		consts.FieldData:     map[string]string{},
+	}
+
+	// Vault only returns the metadata of deleted or destroyed versions, they
+	// are reported without any data.
+	if secret == nil {
+		return result, nil
+	}
+
+	if data, ok := secret.Data[consts.FieldData].(map[string]interface{}); ok {
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling JSON for %q: %s", path, err)
+		}
+		result[consts.FieldDataJSON] = string(jsonData)
+		result[consts.FieldData] = serializeDataMapToString(data)
+	}
+
+	if m, ok := secret.Data[consts.FieldMetadata].(map[string]interface{}); ok {
+		result[consts.FieldCreatedTime], _ = m[consts.FieldCreatedTime].(string)
+		result[consts.FieldDeletionTime], _ = m[consts.FieldDeletionTime].(string)
+		result[consts.FieldDestroyed], _ = m[consts.FieldDestroyed].(bool)
+	}
+
+	return result, nil
+}
+
+// kvV2MetadataInt returns the integer value of the key from the secret's metadata.
+func kvV2MetadataInt(metadata map[string]interface{}, key string) (int64, error) {
+	v, ok := metadata[key].(json.Number)
+	if !ok {
+		return 0, nil
+	}
+
+	i, err := v.Int64()
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", key, v, err)
+	}
+
+	return i, nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceKVSecretVersions(t *testing.T) {
+	t.Parallel()
+	mount := acctest.RandomWithPrefix("tf-kv")
+	name := acctest.RandomWithPrefix("foo")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKVSecretVersionsConfig(mount, name, "v1", ""),
+			},
+			{
+				Config: testDataSourceKVSecretVersionsConfig(mount, name, "v2", ""),
+			},
+			{
+				Config: testDataSourceKVSecretVersionsConfig(mount, name, "v3", `
+data "vault_kv_secret_versions" "last" {
+  mount      = vault_mount.kvv2.path
+  name       = vault_kv_secret_v2.test.name
+  last       = 2
+  depends_on = [vault_kv_secret_v2.test]
+}
+
+data "vault_kv_secret_versions" "versions" {
+  mount      = vault_mount.kvv2.path
+  name       = vault_kv_secret_v2.test.name
+  versions   = [1, 3]
+  depends_on = [vault_kv_secret_v2.test]
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_kv_secret_versions.last", consts.FieldPath, fmt.Sprintf("%s/data/%s", mount, name)),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_versions.last", consts.FieldCurrentVersion, "3"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_versions.last", "secrets.#", "2"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_versions.last", "secrets.0.version", "3"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_versions.last", "secrets.0.data.password", "v3"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_versions.last", "secrets.0.data_json", `{"password":"v3"}`),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_versions.last", "secrets.0.destroyed", "false"),
+					resource.TestCheckResourceAttrSet("data.vault_kv_secret_versions.last", "secrets.0.created_time"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_versions.last", "secrets.1.version", "2"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_versions.last", "secrets.1.data.password", "v2"),
+
+					resource.TestCheckResourceAttr("data.vault_kv_secret_versions.versions", "secrets.#", "2"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_versions.versions", "secrets.0.version", "3"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_versions.versions", "secrets.0.data.password", "v3"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_versions.versions", "secrets.1.version", "1"),
+					resource.TestCheckResourceAttr("data.vault_kv_secret_versions.versions", "secrets.1.data.password", "v1"),
+				),
+			},
+			{
+				Config: testDataSourceKVSecretVersionsConfig(mount, name, "v3", `
+data "vault_kv_secret_versions" "missing" {
+  mount    = vault_mount.kvv2.path
+  name     = vault_kv_secret_v2.test.name
+  versions = [10]
+}
+`),
+				ExpectError: regexp.MustCompile(`version 10 of the secret does not exist`),
+			},
+		},
+	})
+}
+
+func testDataSourceKVSecretVersionsConfig(mount, name, password, dataSources string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_kv_secret_v2" "test" {
+  mount = vault_mount.kvv2.path
+  name  = "%s"
+  data_json = jsonencode(
+    {
+      password = "%s"
+    }
+  )
+}
+%s`, kvV2MountConfig(mount), name, password, dataSources)
+}
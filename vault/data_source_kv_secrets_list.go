@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
@@ -25,6 +26,14 @@ func kvSecretListDataSource() *schema.Resource {
 				ValidateFunc: provider.ValidateNoTrailingSlash,
 			},
 
+			consts.FieldNameRegex: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Regular expression that the listed names must match. " +
+					"The names of nested paths end with a '/'.",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
 			consts.FieldNames: {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -44,7 +53,7 @@ func kvSecretListDataSourceRead(_ context.Context, d *schema.ResourceData, meta
 
 	path := d.Get(consts.FieldPath).(string)
 
-	names, err := kvListRequest(client, path)
+	names, err := kvListRequest(client, path, d.Get(consts.FieldNameRegex).(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
@@ -33,18 +34,27 @@ func kvSecretListDataSourceV2() *schema.Resource {
 					"the name is 'foo/bar/baz'",
 			},
 
+			consts.FieldNameRegex: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Regular expression that the listed names must match. " +
+					"The names of nested paths end with a '/'.",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
 			consts.FieldPath: {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "Full path where the KV-V2 secrets are listed.",
 			},
 
+			// Only the secret names are returned, they are not sensitive so
+			// that they can be used with for_each.
 			consts.FieldNames: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "List of all secret names.",
-				Sensitive:   true,
 			},
 		},
 	}
@@ -65,7 +75,7 @@ func kvSecretV2ListDataSourceRead(_ context.Context, d *schema.ResourceData, met
 		return diag.FromErr(err)
 	}
 
-	names, err := kvListRequest(client, path)
+	names, err := kvListRequest(client, path, d.Get(consts.FieldNameRegex).(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
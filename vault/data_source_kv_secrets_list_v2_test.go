@@ -37,6 +37,8 @@ func TestDataSourceKVSecretListV2(t *testing.T) {
 					resource.TestCheckResourceAttr(datasource1, "names.0", s2),
 					resource.TestCheckResourceAttr(datasource1, "names.1", fmt.Sprintf("%s/", s2)),
 					resource.TestCheckResourceAttr(datasource1, "names.2", s1),
+					resource.TestCheckResourceAttr("data.vault_kv_secrets_list_v2.test_regex", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.vault_kv_secrets_list_v2.test_regex", "names.0", fmt.Sprintf("%s/", s2)),
 				),
 			},
 			{
@@ -95,6 +97,12 @@ data "vault_kv_secrets_list_v2" "test" {
   depends_on = [vault_kv_secret_v2.test_nested, vault_kv_secret_v2.test_1]
 }
 
+data "vault_kv_secrets_list_v2" "test_regex" {
+  mount      = vault_mount.kvv2.path
+  name_regex = "/$"
+  depends_on = [vault_kv_secret_v2.test_nested, vault_kv_secret_v2.test_1]
+}
+
 data "vault_kv_secrets_list_v2" "test_internal" {
   mount      = vault_mount.kvv2.path
   name       = vault_kv_secret_v2.test_2.name
@@ -6,8 +6,8 @@ package vault
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"log"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -72,7 +72,7 @@ func kvSecretSubkeysV2DataSource() *schema.Resource {
 	}
 }
 
-func kvSecretSubkeysDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func kvSecretSubkeysDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, e := provider.GetClient(d, meta)
 	if e != nil {
 		return diag.FromErr(e)
@@ -83,14 +83,13 @@ func kvSecretSubkeysDataSourceRead(_ context.Context, d *schema.ResourceData, me
 
 	path := getKVV2Path(mount, name, "subkeys")
 
+	params := map[string][]string{}
 	if v, ok := d.GetOk(consts.FieldVersion); ok {
-		// add version to path as a query param
-		path = fmt.Sprintf("%s?version=%d", path, v.(int))
+		params[consts.FieldVersion] = []string{strconv.Itoa(v.(int))}
 	}
 
 	if v, ok := d.GetOk(consts.FieldDepth); ok {
-		// add depth to path as a query param
-		path = fmt.Sprintf("%s?depth=%d", path, v.(int))
+		params[consts.FieldDepth] = []string{strconv.Itoa(v.(int))}
 	}
 
 	if err := d.Set(consts.FieldPath, path); err != nil {
@@ -99,10 +98,13 @@ func kvSecretSubkeysDataSourceRead(_ context.Context, d *schema.ResourceData, me
 
 	log.Printf("[DEBUG] Reading subkeys at %s from Vault", path)
 
-	secret, err := client.Logical().Read(path)
+	secret, err := client.Logical().ReadWithDataWithContext(ctx, path, params)
 	if err != nil {
 		return diag.Errorf("error reading subkeys from Vault, err=%s", err)
 	}
+	if secret == nil {
+		return diag.Errorf("no secret found at %q", path)
+	}
 
 	if data, ok := secret.Data["subkeys"]; ok {
 		jsonData, err := json.Marshal(data)
@@ -39,6 +39,17 @@ func TestDataSourceKVSubkeys(t *testing.T) {
 					testutil.CheckJSONData(resourceName, consts.FieldDataJSON, expectedSubkeys),
 				),
 			},
+			{
+				Config: testDataSourceKVSubkeysConfig_depth(mount, secretPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldPath, fmt.Sprintf("%s/subkeys/%s", mount, secretPath)),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldVersion, "1"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDepth, "1"),
+					resource.TestCheckResourceAttr(resourceName, "data.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "data.baz", "null"),
+					testutil.CheckJSONData(resourceName, consts.FieldDataJSON, `{"baz":null,"foo":null,"zip":null}`),
+				),
+			},
 		},
 	})
 }
@@ -68,3 +79,31 @@ data "vault_kv_secret_subkeys_v2" "test" {
 
 	return ret
 }
+
+func testDataSourceKVSubkeysConfig_depth(mount, secretPath string) string {
+	ret := fmt.Sprintf(`
+%s
+
+resource "vault_kv_secret_v2" "test" {
+  mount = vault_mount.kvv2.path
+  name  = "%s"
+  data_json = jsonencode(
+    {
+      zip = "zap",
+      foo = "bar"
+      baz = {
+          riff = "raff"
+        }
+    }
+  )
+}
+
+data "vault_kv_secret_subkeys_v2" "test" {
+  mount   = vault_mount.kvv2.path
+  name    = vault_kv_secret_v2.test.name
+  version = 1
+  depth   = 1
+}`, kvV2MountConfig(mount), secretPath)
+
+	return ret
+}
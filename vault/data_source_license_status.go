@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const licenseStatusPath = "sys/license/status"
+
+func licenseStatusDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: provider.ReadWrapper(licenseStatusDataSourceRead),
+		Schema: map[string]*schema.Schema{
+			consts.FieldAutoloadingUsed: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the license was autoloaded from the server configuration or environment.",
+			},
+			consts.FieldLicenseID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the license.",
+			},
+			consts.FieldStartTime: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the license became valid, in RFC3339 format.",
+			},
+			consts.FieldExpirationTime: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the license expires, in RFC3339 format.",
+			},
+			consts.FieldTerminationTime: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which Vault stops working after the license expired, in RFC3339 format.",
+			},
+			consts.FieldFeatures: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The features enabled by the license.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			consts.FieldPerformanceStandbyCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of performance standby nodes allowed by the license.",
+			},
+		},
+	}
+}
+
+func licenseStatusDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	log.Printf("[DEBUG] Reading license status from Vault")
+	resp, err := client.Logical().Read(licenseStatusPath)
+	if err != nil {
+		return fmt.Errorf("error reading license status from Vault: %s", err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no license status found at %q", licenseStatusPath)
+	}
+
+	// the autoloaded license is the one in use since Vault 1.11
+	license, ok := resp.Data["autoloaded"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("no autoloaded license found at %q", licenseStatusPath)
+	}
+
+	var standbyCount int64
+	if v, ok := license[consts.FieldPerformanceStandbyCount].(json.Number); ok {
+		standbyCount, err = v.Int64()
+		if err != nil {
+			return fmt.Errorf("error parsing %q: %w", consts.FieldPerformanceStandbyCount, err)
+		}
+	}
+
+	data := map[string]interface{}{
+		consts.FieldAutoloadingUsed:         resp.Data[consts.FieldAutoloadingUsed],
+		consts.FieldLicenseID:               license[consts.FieldLicenseID],
+		consts.FieldStartTime:               license[consts.FieldStartTime],
+		consts.FieldExpirationTime:          license[consts.FieldExpirationTime],
+		consts.FieldTerminationTime:         license[consts.FieldTerminationTime],
+		consts.FieldFeatures:                license[consts.FieldFeatures],
+		consts.FieldPerformanceStandbyCount: int(standbyCount),
+	}
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error setting %q for license status: %w", k, err)
+		}
+	}
+
+	// Single instance data source - defaulting ID to 'default'
+	d.SetId("default")
+
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccDataSourceLicenseStatus(t *testing.T) {
+	ds := "data.vault_license_status.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestEntPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLicenseStatusConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(ds, consts.FieldAutoloadingUsed),
+					resource.TestCheckResourceAttrSet(ds, consts.FieldLicenseID),
+					resource.TestCheckResourceAttrSet(ds, consts.FieldExpirationTime),
+					resource.TestCheckResourceAttrSet(ds, consts.FieldFeatures+".#"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceLicenseStatusConfig() string {
+	return `
+data "vault_license_status" "test" {}
+`
+}
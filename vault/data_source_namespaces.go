@@ -40,12 +40,50 @@ func namespacesDataSource() *schema.Resource {
 				Description: "The fully qualified namespace paths.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			consts.FieldNamespaces: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The details of the child namespaces.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldPath: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Namespace path, relative to the namespace.",
+						},
+						consts.FieldPathFQ: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The fully qualified namespace path.",
+						},
+						consts.FieldNamespaceID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Namespace ID.",
+						},
+						consts.FieldCustomMetadata: {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Custom metadata describing the namespace.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
-func namespacesReadNamespacePaths(ctx context.Context, client *api.Client, namespace string, recursive bool) ([]string, diag.Diagnostics) {
-	var allNamespaces []string
+// namespaceInfo holds the details of a child namespace returned by a list
+// request.
+type namespaceInfo struct {
+	pathFQ         string
+	id             string
+	customMetadata map[string]interface{}
+}
+
+func namespacesReadNamespaces(ctx context.Context, client *api.Client, namespace string, recursive bool) ([]namespaceInfo, diag.Diagnostics) {
+	var allNamespaces []namespaceInfo
 
 	client.SetNamespace(namespace)
 
@@ -59,11 +97,24 @@ func namespacesReadNamespacePaths(ctx context.Context, client *api.Client, names
 		prefix = namespace + "/"
 	}
 
+	var keyInfo map[string]interface{}
+	if resp != nil {
+		keyInfo, _ = resp.Data[consts.FieldKeyInfo].(map[string]interface{})
+	}
+
 	for _, ns := range flattenPaths(resp) {
-		allNamespaces = append(allNamespaces, prefix+ns)
+		info := namespaceInfo{
+			pathFQ: prefix + ns,
+		}
+		// the keys of the key_info have a trailing slash
+		if v, ok := keyInfo[ns+"/"].(map[string]interface{}); ok {
+			info.id, _ = v[consts.FieldID].(string)
+			info.customMetadata, _ = v[consts.FieldCustomMetadata].(map[string]interface{})
+		}
+		allNamespaces = append(allNamespaces, info)
 
 		if recursive {
-			subNamespaces, diags := namespacesReadNamespacePaths(ctx, client, prefix+ns, true)
+			subNamespaces, diags := namespacesReadNamespaces(ctx, client, prefix+ns, true)
 			if diags.HasError() {
 				return nil, diags
 			}
@@ -86,24 +137,37 @@ func namespacesDataSourceRead(ctx context.Context, d *schema.ResourceData, meta
 
 	log.Printf("[DEBUG] Reading namespaces from Vault")
 
-	absolutePaths, diags := namespacesReadNamespacePaths(ctx, client, namespace, d.Get("recursive").(bool))
+	namespaces, diags := namespacesReadNamespaces(ctx, client, namespace, d.Get("recursive").(bool))
 	if diags.HasError() {
 		return diags
 	}
 
-	if err := d.Set(consts.FieldPathsFQ, absolutePaths); err != nil {
-		return diag.Errorf("error setting %q to state: %v", consts.FieldPathsFQ, err)
+	var absolutePaths, relativePaths []string
+	var details []map[string]interface{}
+	for _, ns := range namespaces {
+		relativePath := strings.TrimPrefix(ns.pathFQ, namespace+"/")
+		absolutePaths = append(absolutePaths, ns.pathFQ)
+		relativePaths = append(relativePaths, relativePath)
+		details = append(details, map[string]interface{}{
+			consts.FieldPath:           relativePath,
+			consts.FieldPathFQ:         ns.pathFQ,
+			consts.FieldNamespaceID:    ns.id,
+			consts.FieldCustomMetadata: ns.customMetadata,
+		})
 	}
 
-	var relativePaths []string
-	for _, absolutePath := range absolutePaths {
-		relativePaths = append(relativePaths, strings.TrimPrefix(absolutePath, namespace+"/"))
+	if err := d.Set(consts.FieldPathsFQ, absolutePaths); err != nil {
+		return diag.Errorf("error setting %q to state: %v", consts.FieldPathsFQ, err)
 	}
 
 	if err := d.Set(consts.FieldPaths, relativePaths); err != nil {
 		return diag.Errorf("error setting %q to state: %v", consts.FieldPaths, err)
 	}
 
+	if err := d.Set(consts.FieldNamespaces, details); err != nil {
+		return diag.Errorf("error setting %q to state: %v", consts.FieldNamespaces, err)
+	}
+
 	return nil
 }
 
@@ -44,6 +44,10 @@ func TestAccDataSourceNamespaces(t *testing.T) {
 					resource.TestCheckTypeSetElemAttr(resourceName+".test_level0", consts.FieldPathsFQ+".*", ns+"/level1-ns-0"),
 					resource.TestCheckTypeSetElemAttr(resourceName+".test_level0", consts.FieldPathsFQ+".*", ns+"/level1-ns-1"),
 					resource.TestCheckTypeSetElemAttr(resourceName+".test_level0", consts.FieldPathsFQ+".*", ns+"/level1-ns-2"),
+					resource.TestCheckResourceAttr(resourceName+".test_level0", consts.FieldNamespaces+".#", "3"),
+					resource.TestCheckResourceAttr(resourceName+".test_level0", consts.FieldNamespaces+".0.path", "level1-ns-0"),
+					resource.TestCheckResourceAttr(resourceName+".test_level0", consts.FieldNamespaces+".0.path_fq", ns+"/level1-ns-0"),
+					resource.TestCheckResourceAttrSet(resourceName+".test_level0", consts.FieldNamespaces+".0.namespace_id"),
 
 					resource.TestCheckResourceAttr(resourceName+".test_level1", "recursive", "false"),
 					resource.TestCheckResourceAttr(resourceName+".test_level1", consts.FieldNamespace, ns+"/level1-ns-0"),
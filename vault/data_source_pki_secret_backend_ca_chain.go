@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func pkiSecretBackendCAChainDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: provider.ReadContextWrapper(readPKISecretBackendCAChain),
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Full path where PKI backend is mounted.",
+			},
+			consts.FieldIssuerRef: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				Description: "Reference to an existing issuer. Defaults to the default issuer of the mount.",
+			},
+			consts.FieldIssuerID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the issuer.",
+			},
+			consts.FieldIssuerName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the issuer.",
+			},
+			consts.FieldCertificate: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CA certificate of the issuer in PEM format.",
+			},
+			consts.FieldCAChain: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The full CA chain of the issuer as a list of PEM encoded certificates.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			consts.FieldCAChainPEM: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The full CA chain of the issuer as a single PEM bundle.",
+			},
+		},
+	}
+}
+
+func readPKISecretBackendCAChain(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// the issuer endpoints were added with multi-issuer support
+	if !provider.IsAPISupported(meta, provider.VaultVersion111) {
+		return diag.Errorf("feature not enabled on current Vault version. min version required=%s; "+
+			"current vault version=%s", provider.VaultVersion111, meta.(*provider.ProviderMeta).GetVaultVersion())
+	}
+
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backend := d.Get(consts.FieldBackend).(string)
+	issuerRef := d.Get(consts.FieldIssuerRef).(string)
+	path := fmt.Sprintf("%s/issuer/%s/json", backend, issuerRef)
+
+	resp, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading from Vault: %s", err))
+	}
+	log.Printf("[DEBUG] Read %q from Vault", path)
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("no issuer found at %q", path))
+	}
+
+	d.SetId(path)
+
+	for _, k := range []string{
+		consts.FieldIssuerID,
+		consts.FieldIssuerName,
+		consts.FieldCertificate,
+		consts.FieldCAChain,
+	} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var chain []string
+	if v, ok := resp.Data[consts.FieldCAChain].([]interface{}); ok {
+		for _, cert := range v {
+			chain = append(chain, strings.TrimSpace(cert.(string)))
+		}
+	}
+	if err := d.Set(consts.FieldCAChainPEM, strings.Join(chain, "\n")); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccDataSourcePKISecretBackendCAChain(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-pki-backend")
+	issuerName := acctest.RandomWithPrefix("tf-test-pki-issuer")
+	defaultName := "data.vault_pki_secret_backend_ca_chain.default"
+	namedName := "data.vault_pki_secret_backend_ca_chain.named"
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			SkipIfAPIVersionLT(t, testProvider.Meta(), provider.VaultVersion111)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testPKISecretBackendCAChainDataSource(backend, issuerName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(defaultName, consts.FieldIssuerRef, "default"),
+					resource.TestCheckResourceAttr(defaultName, consts.FieldIssuerName, issuerName),
+					resource.TestCheckResourceAttrPair(defaultName, consts.FieldIssuerID,
+						"vault_pki_secret_backend_root_cert.test", consts.FieldIssuerID),
+					resource.TestCheckResourceAttrPair(defaultName, consts.FieldCertificate,
+						"vault_pki_secret_backend_root_cert.test", consts.FieldCertificate),
+					resource.TestCheckResourceAttr(defaultName, consts.FieldCAChain+".#", "1"),
+					resource.TestCheckResourceAttrSet(defaultName, consts.FieldCAChainPEM),
+					resource.TestCheckResourceAttr(namedName, consts.FieldIssuerName, issuerName),
+					resource.TestCheckResourceAttrPair(namedName, consts.FieldCAChainPEM,
+						defaultName, consts.FieldCAChainPEM),
+				),
+			},
+		},
+	})
+}
+
+func testPKISecretBackendCAChainDataSource(path, issuerName string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path        = "%s"
+  type        = "pki"
+  description = "PKI secret engine mount"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "test"
+  ttl         = "86400"
+  issuer_name = "%s"
+}
+
+data "vault_pki_secret_backend_ca_chain" "default" {
+  backend    = vault_mount.test.path
+  depends_on = [vault_pki_secret_backend_root_cert.test]
+}
+
+data "vault_pki_secret_backend_ca_chain" "named" {
+  backend    = vault_mount.test.path
+  issuer_ref = vault_pki_secret_backend_root_cert.test.issuer_name
+}`, path, issuerName)
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func pkiSecretBackendCertDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: provider.ReadContextWrapper(readPKISecretBackendCert),
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Full path where PKI backend is mounted.",
+			},
+			consts.FieldSerial: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Specifies the serial of the certificate to read.",
+			},
+			consts.FieldCertificate: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM encoded certificate.",
+			},
+			consts.FieldSerialNumber: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The certificate's serial number, hex formatted.",
+			},
+			consts.FieldIssuerID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the issuer that signed the certificate.",
+			},
+			consts.FieldSubject: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subject of the certificate.",
+			},
+			consts.FieldCommonName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The common name of the certificate.",
+			},
+			consts.FieldAltNames: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The DNS and email subject alternative names of the certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			consts.FieldIPSans: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IP subject alternative names of the certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			consts.FieldURISans: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The URI subject alternative names of the certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			consts.FieldNotBefore: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The start of the validity period of the certificate in RFC 3339 format.",
+			},
+			consts.FieldNotAfter: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The end of the validity period of the certificate in RFC 3339 format.",
+			},
+			consts.FieldRevocationTime: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The revocation time of the certificate as a Unix-style timestamp, 0 if not revoked.",
+			},
+			consts.FieldRevoked: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the certificate was revoked.",
+			},
+		},
+	}
+}
+
+func readPKISecretBackendCert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backend := strings.Trim(d.Get(consts.FieldBackend).(string), "/")
+	serial := d.Get(consts.FieldSerial).(string)
+	path := fmt.Sprintf("%s/cert/%s", backend, serial)
+
+	resp, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return diag.Errorf("error reading certificate from %q: %s", path, err)
+	}
+	if resp == nil {
+		return diag.Errorf("no certificate found at %q", path)
+	}
+
+	cert, err := pkiParseCertificatePEM(resp.Data[consts.FieldCertificate])
+	if err != nil {
+		return diag.Errorf("error parsing certificate at %q: %s", path, err)
+	}
+
+	d.SetId(path)
+
+	var revocationTime int64
+	if v, ok := resp.Data[consts.FieldRevocationTime].(json.Number); ok {
+		revocationTime, err = v.Int64()
+		if err != nil {
+			return diag.Errorf("error parsing revocation time of certificate at %q: %s", path, err)
+		}
+	}
+
+	var ipSans []string
+	for _, ip := range cert.IPAddresses {
+		ipSans = append(ipSans, ip.String())
+	}
+
+	var uriSans []string
+	for _, u := range cert.URIs {
+		uriSans = append(uriSans, u.String())
+	}
+
+	fields := map[string]interface{}{
+		consts.FieldCertificate:    resp.Data[consts.FieldCertificate],
+		consts.FieldSerialNumber:   pkiFormatSerialNumber(cert.SerialNumber.Bytes()),
+		consts.FieldIssuerID:       resp.Data[consts.FieldIssuerID],
+		consts.FieldSubject:        cert.Subject.String(),
+		consts.FieldCommonName:     cert.Subject.CommonName,
+		consts.FieldAltNames:       append(append([]string{}, cert.DNSNames...), cert.EmailAddresses...),
+		consts.FieldIPSans:         ipSans,
+		consts.FieldURISans:        uriSans,
+		consts.FieldNotBefore:      cert.NotBefore.UTC().Format(time.RFC3339),
+		consts.FieldNotAfter:       cert.NotAfter.UTC().Format(time.RFC3339),
+		consts.FieldRevocationTime: revocationTime,
+		consts.FieldRevoked:        revocationTime > 0,
+	}
+
+	for k, v := range fields {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// pkiFormatSerialNumber formats the serial number the way Vault does, as
+// colon separated hex bytes.
+func pkiFormatSerialNumber(b []byte) string {
+	parts := make([]string, len(b))
+	for i, v := range b {
+		parts[i] = fmt.Sprintf("%02x", v)
+	}
+
+	return strings.Join(parts, ":")
+}
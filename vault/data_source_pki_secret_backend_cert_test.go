@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccDataSourcePKISecretBackendCert(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-pki-backend")
+	dataName := "data.vault_pki_secret_backend_cert.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPKISecretBackendCertDataSource(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, consts.FieldBackend, backend),
+					resource.TestCheckResourceAttrPair(dataName, consts.FieldSerialNumber,
+						"vault_pki_secret_backend_cert.test", consts.FieldSerialNumber),
+					resource.TestCheckResourceAttrPair(dataName, consts.FieldCertificate,
+						"vault_pki_secret_backend_cert.test", consts.FieldCertificate),
+					resource.TestCheckResourceAttr(dataName, consts.FieldCommonName, "cert.test.my.domain"),
+					resource.TestCheckResourceAttr(dataName, consts.FieldSubject, "CN=cert.test.my.domain"),
+					resource.TestCheckResourceAttr(dataName, "alt_names.#", "2"),
+					resource.TestCheckResourceAttr(dataName, "alt_names.0", "cert.test.my.domain"),
+					resource.TestCheckResourceAttr(dataName, "alt_names.1", "alt.test.my.domain"),
+					resource.TestCheckResourceAttr(dataName, "ip_sans.#", "1"),
+					resource.TestCheckResourceAttr(dataName, "ip_sans.0", "127.0.0.1"),
+					resource.TestCheckResourceAttr(dataName, "uri_sans.#", "1"),
+					resource.TestCheckResourceAttr(dataName, "uri_sans.0", "spiffe://test.my.domain"),
+					resource.TestCheckResourceAttrSet(dataName, consts.FieldNotBefore),
+					resource.TestCheckResourceAttrSet(dataName, consts.FieldNotAfter),
+					resource.TestCheckResourceAttr(dataName, consts.FieldRevocationTime, "0"),
+					resource.TestCheckResourceAttr(dataName, consts.FieldRevoked, "false"),
+				),
+			},
+		},
+	})
+}
+
+func testPKISecretBackendCertDataSource(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path                      = "%s"
+  type                      = "pki"
+  default_lease_ttl_seconds = 86400
+  max_lease_ttl_seconds     = 86400
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "my.domain"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend          = vault_pki_secret_backend_root_cert.test.backend
+  name             = "test"
+  allowed_domains  = ["test.my.domain"]
+  allow_subdomains = true
+  allowed_uri_sans = ["spiffe://test.my.domain"]
+  max_ttl          = "3600"
+}
+
+resource "vault_pki_secret_backend_cert" "test" {
+  backend     = vault_pki_secret_backend_role.test.backend
+  name        = vault_pki_secret_backend_role.test.name
+  common_name = "cert.test.my.domain"
+  alt_names   = ["alt.test.my.domain"]
+  ip_sans     = ["127.0.0.1"]
+  uri_sans    = ["spiffe://test.my.domain"]
+  ttl         = "1h"
+}
+
+data "vault_pki_secret_backend_cert" "test" {
+  backend = vault_mount.test.path
+  serial  = vault_pki_secret_backend_cert.test.serial_number
+}
+`, backend)
+}
+
+func TestPkiFormatSerialNumber(t *testing.T) {
+	tests := []struct {
+		name string
+		b    []byte
+		want string
+	}{
+		{
+			name: "single-byte",
+			b:    []byte{0x0a},
+			want: "0a",
+		},
+		{
+			name: "multiple-bytes",
+			b:    []byte{0x3b, 0x00, 0xff, 0x12},
+			want: "3b:00:ff:12",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pkiFormatSerialNumber(tt.b); got != tt.want {
+				t.Errorf("pkiFormatSerialNumber() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
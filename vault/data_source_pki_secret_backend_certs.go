@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func pkiSecretBackendCertsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: provider.ReadContextWrapper(readPKISecretBackendCerts),
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Full path where PKI backend is mounted.",
+			},
+			consts.FieldNameRegex: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Regular expression that the listed serials must match.",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			consts.FieldKeys: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Serials of the certificates stored under the backend path.",
+			},
+		},
+	}
+}
+
+func readPKISecretBackendCerts(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backend := d.Get(consts.FieldBackend).(string)
+	path := fmt.Sprintf("%s/certs", backend)
+
+	resp, err := client.Logical().ListWithContext(ctx, path)
+	if err != nil {
+		return diag.Errorf("error reading from Vault: %s", err)
+	}
+	log.Printf("[DEBUG] Read %q from Vault", path)
+
+	d.SetId(path)
+
+	// Vault returns no data if there are no certificates
+	var keys []interface{}
+	if resp != nil {
+		keys, _ = resp.Data[consts.FieldKeys].([]interface{})
+	}
+
+	keys, err = filterKVNames(keys, d.Get(consts.FieldNameRegex).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(consts.FieldKeys, keys); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
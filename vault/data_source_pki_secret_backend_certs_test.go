@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccDataSourcePKISecretBackendCerts(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-pki-backend")
+	dataName := "data.vault_pki_secret_backend_certs.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPKISecretBackendCertsDataSource(backend, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, consts.FieldBackend, backend),
+					// the root certificate and the issued certificate
+					resource.TestCheckResourceAttr(dataName, "keys.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataName, "keys.*",
+						"vault_pki_secret_backend_cert.test", consts.FieldSerialNumber),
+				),
+			},
+			{
+				Config: testPKISecretBackendCertsDataSource(backend, `name_regex = "^$"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testPKISecretBackendCertsDataSource(backend, extraFields string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "my.domain"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend          = vault_pki_secret_backend_root_cert.test.backend
+  name             = "test"
+  allowed_domains  = ["test.my.domain"]
+  allow_subdomains = true
+}
+
+resource "vault_pki_secret_backend_cert" "test" {
+  backend     = vault_pki_secret_backend_role.test.backend
+  name        = vault_pki_secret_backend_role.test.name
+  common_name = "cert.test.my.domain"
+  ttl         = "1h"
+}
+
+data "vault_pki_secret_backend_certs" "test" {
+  backend = vault_pki_secret_backend_cert.test.backend
+  %s
+}
+`, backend, extraFields)
+}
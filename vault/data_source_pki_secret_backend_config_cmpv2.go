@@ -5,7 +5,6 @@ package vault
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -82,7 +81,7 @@ func pkiSecretBackendConfigCMPV2DataSource() *schema.Resource {
 }
 
 func readPKISecretBackendConfigCMPV2(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if err := verifyPkiEstFeatureSupported(meta); err != nil {
+	if err := verifyPkiCMPV2FeatureSupported(meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -141,18 +140,7 @@ func readCMPV2Config(ctx context.Context, d *schema.ResourceData, client *api.Cl
 // verifyPkiCMPV2FeatureSupported verifies that we are talking to a Vault enterprise edition
 // and its version 1.18.0 or higher, returns nil if the above is met, otherwise an error
 func verifyPkiCMPV2FeatureSupported(meta interface{}) error {
-	currentVersion := meta.(*provider.ProviderMeta).GetVaultVersion()
-
-	minVersion := provider.VaultVersion118
-	if !provider.IsAPISupported(meta, minVersion) {
-		return fmt.Errorf("feature not enabled on current Vault version. min version required=%s; "+
-			"current vault version=%s", minVersion, currentVersion)
-	}
-
-	if !provider.IsEnterpriseSupported(meta) {
-		return errors.New("feature requires Vault Enterprise")
-	}
-	return nil
+	return provider.CheckFeatureSupported(meta, provider.VaultVersion118, true)
 }
 
 func pkiSecretBackendConfigCMPV2Path(backend string) string {
@@ -5,7 +5,6 @@ package vault
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
 
@@ -149,18 +148,7 @@ func readEstConfig(ctx context.Context, d *schema.ResourceData, client *api.Clie
 // verifyPkiEstFeatureSupported verifies that we are talking to a Vault enterprise edition
 // and its version 1.16.0 or higher, returns nil if the above is met, otherwise an error
 func verifyPkiEstFeatureSupported(meta interface{}) error {
-	currentVersion := meta.(*provider.ProviderMeta).GetVaultVersion()
-
-	minVersion := provider.VaultVersion116
-	if !provider.IsAPISupported(meta, minVersion) {
-		return fmt.Errorf("feature not enabled on current Vault version. min version required=%s; "+
-			"current vault version=%s", minVersion, currentVersion)
-	}
-
-	if !provider.IsEnterpriseSupported(meta) {
-		return errors.New("feature requires Vault Enterprise")
-	}
-	return nil
+	return provider.CheckFeatureSupported(meta, provider.VaultVersion116, true)
 }
 
 func pkiSecretBackendConfigEstPath(backend string) string {
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func pkiSecretBackendCRLDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: provider.ReadContextWrapper(readPKISecretBackendCRL),
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Full path where PKI backend is mounted.",
+			},
+			consts.FieldIssuerRef: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				Description: "Reference to an existing issuer. Defaults to the default issuer of the mount.",
+			},
+			consts.FieldCRL: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current CRL of the issuer in PEM format.",
+			},
+			consts.FieldDeltaCRL: {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The current delta CRL of the issuer in PEM format. " +
+					"Only set when delta CRLs are enabled on the mount.",
+			},
+		},
+	}
+}
+
+func readPKISecretBackendCRL(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// the issuer delta CRL endpoint was added after multi-issuer support
+	if !provider.IsAPISupported(meta, provider.VaultVersion112) {
+		return diag.Errorf("feature not enabled on current Vault version. min version required=%s; "+
+			"current vault version=%s", provider.VaultVersion112, meta.(*provider.ProviderMeta).GetVaultVersion())
+	}
+
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backend := d.Get(consts.FieldBackend).(string)
+	issuerRef := d.Get(consts.FieldIssuerRef).(string)
+	path := fmt.Sprintf("%s/issuer/%s/crl", backend, issuerRef)
+
+	resp, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading from Vault: %s", err))
+	}
+	log.Printf("[DEBUG] Read %q from Vault", path)
+	if resp == nil {
+		return diag.FromErr(fmt.Errorf("no CRL found at %q", path))
+	}
+
+	if err := d.Set(consts.FieldCRL, resp.Data[consts.FieldCRL]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The delta CRL is only built when delta CRLs are enabled on the mount,
+	// so tolerate an empty response here.
+	deltaPath := path + "/delta"
+	deltaResp, err := client.Logical().ReadWithContext(ctx, deltaPath)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading from Vault: %s", err))
+	}
+	log.Printf("[DEBUG] Read %q from Vault", deltaPath)
+
+	var deltaCRL interface{}
+	if deltaResp != nil {
+		deltaCRL = deltaResp.Data[consts.FieldCRL]
+	}
+	if err := d.Set(consts.FieldDeltaCRL, deltaCRL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(path)
+
+	return nil
+}
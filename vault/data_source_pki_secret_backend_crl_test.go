@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccDataSourcePKISecretBackendCRL(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-pki-backend")
+	dataName := "data.vault_pki_secret_backend_crl.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			SkipIfAPIVersionLT(t, testProvider.Meta(), provider.VaultVersion112)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testPKISecretBackendCRLDataSource(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, consts.FieldIssuerRef, "default"),
+					resource.TestCheckResourceAttrSet(dataName, consts.FieldCRL),
+					resource.TestCheckResourceAttrSet(dataName, consts.FieldDeltaCRL),
+				),
+			},
+		},
+	})
+}
+
+func testPKISecretBackendCRLDataSource(path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path        = "%s"
+  type        = "pki"
+  description = "PKI secret engine mount"
+}
+
+resource "vault_pki_secret_backend_crl_config" "test" {
+  backend      = vault_mount.test.path
+  auto_rebuild = true
+  enable_delta = true
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "test"
+  ttl         = "86400"
+  depends_on  = [vault_pki_secret_backend_crl_config.test]
+}
+
+data "vault_pki_secret_backend_crl" "test" {
+  backend    = vault_mount.test.path
+  depends_on = [vault_pki_secret_backend_root_cert.test]
+}`, path)
+}
+
+func TestDataSourcePKISecretBackendIssuerEndpointsVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource *schema.Resource
+		version  string
+		wantErr  bool
+	}{
+		{
+			name:     "ca-chain-supported",
+			resource: pkiSecretBackendCAChainDataSource(),
+			version:  "1.11.0",
+		},
+		{
+			name:     "ca-chain-unsupported",
+			resource: pkiSecretBackendCAChainDataSource(),
+			version:  "1.10.0",
+			wantErr:  true,
+		},
+		{
+			name:     "crl-supported",
+			resource: pkiSecretBackendCRLDataSource(),
+			version:  "1.12.0",
+		},
+		{
+			name:     "crl-unsupported",
+			resource: pkiSecretBackendCRLDataSource(),
+			version:  "1.11.0",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := testMockVaultMeta(t, func(w http.ResponseWriter, r *http.Request) {
+				var data map[string]interface{}
+				switch {
+				case r.URL.Path == "/v1/sys/seal-status":
+					w.Header().Set("Content-Type", "application/json")
+					json.NewEncoder(w).Encode(map[string]interface{}{
+						consts.FieldVersion: tt.version,
+					})
+					return
+				case strings.HasSuffix(r.URL.Path, "/json"):
+					data = map[string]interface{}{
+						consts.FieldIssuerID:    "id-1",
+						consts.FieldCertificate: "cert",
+						consts.FieldCAChain:     []string{"cert"},
+					}
+				case strings.HasSuffix(r.URL.Path, "/crl"), strings.HasSuffix(r.URL.Path, "/crl/delta"):
+					data = map[string]interface{}{
+						consts.FieldCRL: "crl",
+					}
+				default:
+					w.WriteHeader(http.StatusNotImplemented)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"data": data,
+				})
+			})
+
+			d := schema.TestResourceDataRaw(t, tt.resource.Schema, map[string]interface{}{
+				consts.FieldBackend: "pki",
+			})
+
+			diags := tt.resource.ReadContext(context.Background(), d, meta)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("read error = %v, wantErr %v", diags, tt.wantErr)
+			}
+		})
+	}
+}
@@ -8,9 +8,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
@@ -26,6 +28,12 @@ func pkiSecretBackendIssuersDataSource() *schema.Resource {
 				ForceNew:    true,
 				Description: "Full path where PKI backend is mounted.",
 			},
+			consts.FieldNameRegex: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Regular expression that the names of the listed issuers must match.",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
 			consts.FieldKeys: {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -72,7 +80,14 @@ func readPKISecretBackendIssuers(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(path)
 
-	if err := d.Set(consts.FieldKeys, resp.Data[consts.FieldKeys]); err != nil {
+	keys, _ := resp.Data[consts.FieldKeys].([]interface{})
+	keyInfo, _ := resp.Data[consts.FieldKeyInfo].(map[string]interface{})
+	keys, err = filterPKIIssuers(keys, keyInfo, d.Get(consts.FieldNameRegex).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(consts.FieldKeys, keys); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -92,3 +107,30 @@ func readPKISecretBackendIssuers(ctx context.Context, d *schema.ResourceData, me
 
 	return nil
 }
+
+// filterPKIIssuers returns the issuer IDs whose name matches the regular
+// expression, the key info of the other issuers is removed. All issuers are
+// returned if the expression is empty.
+func filterPKIIssuers(keys []interface{}, keyInfo map[string]interface{}, expr string) ([]interface{}, error) {
+	if expr == "" {
+		return keys, nil
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %q %q, err=%w", consts.FieldNameRegex, expr, err)
+	}
+
+	filtered := keys[:0]
+	for _, k := range keys {
+		id, _ := k.(string)
+		info, _ := keyInfo[id].(map[string]interface{})
+		if name, ok := info[consts.FieldIssuerName].(string); ok && re.MatchString(name) {
+			filtered = append(filtered, k)
+			continue
+		}
+		delete(keyInfo, id)
+	}
+
+	return filtered, nil
+}
@@ -6,6 +6,7 @@ package vault
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -36,6 +37,20 @@ func TestAccDataSourcePKISecretIssuers(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataName, consts.FieldKeyInfoJSON),
 				),
 			},
+			{
+				Config: testPKISecretIssuersDataSource_nameRegex(backend, issuerName, "^tf-test-pki-issuer"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "keys.#", "1"),
+					resource.TestCheckResourceAttr(dataName, "key_info.%", "1"),
+				),
+			},
+			{
+				Config: testPKISecretIssuersDataSource_nameRegex(backend, issuerName, "^other"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "keys.#", "0"),
+					resource.TestCheckResourceAttr(dataName, "key_info.%", "0"),
+				),
+			},
 		},
 	})
 }
@@ -60,3 +75,47 @@ data "vault_pki_secret_backend_issuers" "test" {
   backend     = vault_pki_secret_backend_root_cert.test.backend
 }`, path, issuerName)
 }
+
+func testPKISecretIssuersDataSource_nameRegex(path, issuerName, nameRegex string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path        = "%s"
+  type        = "pki"
+  description = "PKI secret engine mount"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "test"
+  ttl         = "86400"
+  issuer_name = "%s"
+}
+
+data "vault_pki_secret_backend_issuers" "test" {
+  backend    = vault_pki_secret_backend_root_cert.test.backend
+  name_regex = "%s"
+}`, path, issuerName, nameRegex)
+}
+
+func TestFilterPKIIssuers(t *testing.T) {
+	keyInfo := map[string]interface{}{
+		"id-1": map[string]interface{}{consts.FieldIssuerName: "root-2024"},
+		"id-2": map[string]interface{}{consts.FieldIssuerName: "root-2025"},
+		"id-3": map[string]interface{}{consts.FieldIssuerName: "intermediate"},
+		"id-4": map[string]interface{}{},
+	}
+
+	keys, err := filterPKIIssuers([]interface{}{"id-1", "id-2", "id-3", "id-4"}, keyInfo, "^root-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(keys, []interface{}{"id-1", "id-2"}) {
+		t.Errorf("filterPKIIssuers() keys = %v, want [id-1 id-2]", keys)
+	}
+
+	if len(keyInfo) != 2 {
+		t.Errorf("filterPKIIssuers() key info = %v, want 2 entries", keyInfo)
+	}
+}
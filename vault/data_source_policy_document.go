@@ -310,9 +310,46 @@ func policyDecodeConfigListOfMapsOfListToString(input []interface{}) (map[string
 	return output, nil
 }
 
+// policyQuote returns the string as a quoted HCL string. Only the escape
+// sequences supported by HCL are used, quotes, backslashes, newlines, carriage
+// returns and tabs are escaped, other control characters are written as
+// \uXXXX and all other characters are written as is.
+func policyQuote(s string) string {
+	var b strings.Builder
+	b.Grow(len(s) + 2)
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&b, `\u%04x`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+
+	return b.String()
+}
+
 func policyRenderListOfStrings(items []string) string {
 	if len(items) > 0 {
-		return fmt.Sprintf(`["%s"]`, strings.Join(items, `", "`))
+		quoted := make([]string, len(items))
+		for i, item := range items {
+			quoted[i] = policyQuote(item)
+		}
+		return fmt.Sprintf(`[%s]`, strings.Join(quoted, ", "))
 	}
 
 	return "[]"
@@ -328,18 +365,20 @@ func policyRenderListOfMapsOfListToString(input map[string][]string) string {
 	sort.Strings(keys)
 
 	for _, k := range keys {
-		output = fmt.Sprintf("%s    \"%s\" = %s\n", output, k, policyRenderListOfStrings(input[k]))
+		output = fmt.Sprintf("%s    %s = %s\n", output, policyQuote(k), policyRenderListOfStrings(input[k]))
 	}
 
 	return fmt.Sprintf("%s  }", output)
 }
 
 func policyRenderPolicyRule(rule *PolicyRule) string {
-	renderedRule := fmt.Sprintf("path \"%s\" {\n", rule.Path)
+	renderedRule := fmt.Sprintf("path %s {\n", policyQuote(rule.Path))
 	renderedRule = fmt.Sprintf("%s  capabilities = %s\n", renderedRule, policyRenderListOfStrings(rule.Capabilities))
 
 	if rule.Description != "" {
-		renderedRule = fmt.Sprintf("# %s\n%s", rule.Description, renderedRule)
+		// every line of a multi-line description is rendered as a comment
+		comment := "# " + strings.ReplaceAll(rule.Description, "\n", "\n# ")
+		renderedRule = fmt.Sprintf("%s\n%s", comment, renderedRule)
 	}
 
 	if len(rule.RequiredParameters) > 0 {
@@ -359,11 +398,11 @@ func policyRenderPolicyRule(rule *PolicyRule) string {
 	}
 
 	if rule.MinWrappingTTL != "" {
-		renderedRule = fmt.Sprintf("%s  min_wrapping_ttl = \"%s\"\n", renderedRule, rule.MinWrappingTTL)
+		renderedRule = fmt.Sprintf("%s  min_wrapping_ttl = %s\n", renderedRule, policyQuote(rule.MinWrappingTTL))
 	}
 
 	if rule.MaxWrappingTTL != "" {
-		renderedRule = fmt.Sprintf("%s  max_wrapping_ttl = \"%s\"\n", renderedRule, rule.MaxWrappingTTL)
+		renderedRule = fmt.Sprintf("%s  max_wrapping_ttl = %s\n", renderedRule, policyQuote(rule.MaxWrappingTTL))
 	}
 
 	return fmt.Sprintf("%s}\n", renderedRule)
@@ -153,3 +153,69 @@ func testDataSourcePolicyDocument_check(s *terraform.State) error {
 
 	return nil
 }
+
+func TestPolicyRenderPolicyRule_escaping(t *testing.T) {
+	rule := &PolicyRule{
+		Path:         `secret/data/"quoted"`,
+		Description:  "first line\nsecond line",
+		Capabilities: []string{"read"},
+		AllowedParameters: map[string][]string{
+			`back\slash`: {`a"b`},
+		},
+	}
+
+	expected := `# first line
+# second line
+path "secret/data/\"quoted\"" {
+  capabilities = ["read"]
+  allowed_parameters = {
+    "back\\slash" = ["a\"b"]
+  }
+}
+`
+	if actual := policyRenderPolicyRule(rule); actual != expected {
+		t.Fatalf("expected rendered rule:\n%s\ngot:\n%s", expected, actual)
+	}
+}
+
+func TestPolicyQuote(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			name: "plain",
+			s:    "secret/data/foo",
+			want: `"secret/data/foo"`,
+		},
+		{
+			name: "quotes-and-backslashes",
+			s:    `a"b\c`,
+			want: `"a\"b\\c"`,
+		},
+		{
+			name: "whitespace",
+			s:    "a\nb\rc\td",
+			want: `"a\nb\rc\td"`,
+		},
+		{
+			name: "control-characters",
+			s:    "a\x00b\x1bc\x7fd\ae",
+			want: `"a\u0000b\u001bc\u007fd\u0007e"`,
+		},
+		{
+			name: "unicode",
+			s:    "secret/data/caf\u00e9/\u2028",
+			want: "\"secret/data/caf\u00e9/\u2028\"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policyQuote(tt.s); got != tt.want {
+				t.Errorf("policyQuote() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
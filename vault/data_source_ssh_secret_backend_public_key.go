@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func sshSecretBackendPublicKeyDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: provider.ReadContextWrapper(readSSHBackendPublicKey),
+		Schema: map[string]*schema.Schema{
+			consts.FieldPath: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Full path where SSH backend is mounted.",
+			},
+			consts.FieldPublicKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The public key of the SSH CA, in OpenSSH authorized_keys format.",
+			},
+		},
+	}
+}
+
+func readSSHBackendPublicKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.Errorf("failed getting client: %v", e)
+	}
+
+	backend := strings.Trim(d.Get(consts.FieldPath).(string), "/")
+	path := backend + "/config/ca"
+
+	log.Printf("[DEBUG] Reading CA public key from SSH backend %q", backend)
+	secret, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return diag.Errorf("error reading CA public key from SSH backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Read CA public key from SSH backend %q", backend)
+
+	if secret == nil {
+		return diag.Errorf("no CA public key found in SSH backend %q", backend)
+	}
+
+	if err := d.Set(consts.FieldPublicKey, secret.Data[consts.FieldPublicKey]); err != nil {
+		return diag.Errorf("error setting %q: %s", consts.FieldPublicKey, err)
+	}
+
+	d.SetId(path)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccDataSourceSSHSecretBackendPublicKey(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ssh")
+	dataName := "data.vault_ssh_secret_backend_public_key.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testSSHSecretBackendPublicKeyDataSource(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, consts.FieldPath, backend),
+					resource.TestCheckResourceAttrPair(dataName, consts.FieldPublicKey,
+						"vault_ssh_secret_backend_ca.test", consts.FieldPublicKey),
+				),
+			},
+		},
+	})
+}
+
+func testSSHSecretBackendPublicKeyDataSource(path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "ssh"
+}
+
+resource "vault_ssh_secret_backend_ca" "test" {
+  backend              = vault_mount.test.path
+  generate_signing_key = true
+}
+
+data "vault_ssh_secret_backend_public_key" "test" {
+  path       = vault_mount.test.path
+  depends_on = [vault_ssh_secret_backend_ca.test]
+}
+`, path)
+}
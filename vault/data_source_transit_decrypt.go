@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
 
@@ -39,9 +40,26 @@ func transitDecryptDataSource() *schema.Resource {
 				Description: "Specifies the context for key derivation",
 			},
 			"ciphertext": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Transit encrypted cipher text.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"ciphertext", consts.FieldBatchInput},
+				Description:  "Transit encrypted cipher text.",
+			},
+			consts.FieldBatchInput: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"ciphertext", consts.FieldBatchInput},
+				Description: "Specifies a list of items to be decrypted in a single request. Each item supports the " +
+					"'ciphertext', 'context' and 'reference' fields. The 'context' value is base64 encoded by the provider. " +
+					"Any batch output will preserve the order of the batch input.",
+				Elem: &schema.Schema{Type: schema.TypeMap},
+			},
+			consts.FieldBatchResults: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The results returned from Vault if using batch_input. The 'plaintext' of each result is base64 decoded.",
+				Elem:        &schema.Schema{Type: schema.TypeMap},
 			},
 		},
 	}
@@ -55,6 +73,42 @@ func transitDecryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 
 	backend := d.Get("backend").(string)
 	key := d.Get("key").(string)
+	reqPath := backend + "/decrypt/" + key
+
+	if batchInput, ok := d.GetOk(consts.FieldBatchInput); ok {
+		batch, err := convertBatchInput(batchInput, nil)
+		if err != nil {
+			return err
+		}
+		for _, item := range batch {
+			encodeTransitBatchItemFields(item, consts.FieldContext)
+		}
+
+		decryptedData, err := client.Logical().Write(reqPath, map[string]interface{}{
+			consts.FieldBatchInput: batch,
+		})
+		if err != nil {
+			return fmt.Errorf("issue decrypting with key: %s", err)
+		}
+
+		batchResults, err := convertBatchResults(decryptedData.Data[consts.FieldBatchResults])
+		if err != nil {
+			return err
+		}
+		for _, result := range batchResults {
+			if v, ok := result["plaintext"].(string); ok {
+				plaintext, err := base64.StdEncoding.DecodeString(v)
+				if err != nil {
+					return fmt.Errorf("error decoding plaintext from batch results: %s", err)
+				}
+				result["plaintext"] = string(plaintext)
+			}
+		}
+
+		d.SetId(reqPath)
+		return d.Set(consts.FieldBatchResults, batchResults)
+	}
+
 	ciphertext := d.Get("ciphertext").(string)
 
 	context := base64.StdEncoding.EncodeToString([]byte(d.Get("context").(string)))
@@ -63,7 +117,7 @@ func transitDecryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 		"context":    context,
 	}
 
-	decryptedData, err := client.Logical().Write(backend+"/decrypt/"+key, payload)
+	decryptedData, err := client.Logical().Write(reqPath, payload)
 	if err != nil {
 		return fmt.Errorf("issue encrypting with key: %s", err)
 	}
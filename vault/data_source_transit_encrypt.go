@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
 
@@ -28,10 +29,11 @@ func transitEncryptDataSource() *schema.Resource {
 				Description: "The Transit secret backend the key belongs to.",
 			},
 			"plaintext": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Map of strings read from Vault.",
-				Sensitive:   true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"plaintext", consts.FieldBatchInput},
+				Description:  "Map of strings read from Vault.",
+				Sensitive:    true,
 			},
 			"context": {
 				Type:        schema.TypeString,
@@ -48,6 +50,22 @@ func transitEncryptDataSource() *schema.Resource {
 				Computed:    true,
 				Description: "Transit encrypted cipher text.",
 			},
+			consts.FieldBatchInput: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"plaintext", consts.FieldBatchInput},
+				Sensitive:    true,
+				Description: "Specifies a list of items to be encrypted in a single request. Each item supports the " +
+					"'plaintext', 'context', 'key_version' and 'reference' fields. The 'plaintext' and 'context' " +
+					"values are base64 encoded by the provider. Any batch output will preserve the order of the batch input.",
+				Elem: &schema.Schema{Type: schema.TypeMap},
+			},
+			consts.FieldBatchResults: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The results returned from Vault if using batch_input.",
+				Elem:        &schema.Schema{Type: schema.TypeMap},
+			},
 		},
 	}
 }
@@ -61,6 +79,32 @@ func transitEncryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 	backend := d.Get("backend").(string)
 	key := d.Get("key").(string)
 	keyVersion := d.Get("key_version").(int)
+	reqPath := backend + "/encrypt/" + key
+
+	if batchInput, ok := d.GetOk(consts.FieldBatchInput); ok {
+		batch, err := convertBatchInput(batchInput, []string{consts.FieldKeyVersion})
+		if err != nil {
+			return err
+		}
+		for _, item := range batch {
+			encodeTransitBatchItemFields(item, "plaintext", consts.FieldContext)
+		}
+
+		encryptedData, err := client.Logical().Write(reqPath, map[string]interface{}{
+			consts.FieldBatchInput: batch,
+		})
+		if err != nil {
+			return fmt.Errorf("issue encrypting with key: %s", err)
+		}
+
+		batchResults, err := convertBatchResults(encryptedData.Data[consts.FieldBatchResults])
+		if err != nil {
+			return err
+		}
+
+		d.SetId(reqPath)
+		return d.Set(consts.FieldBatchResults, batchResults)
+	}
 
 	plaintext := base64.StdEncoding.EncodeToString([]byte(d.Get("plaintext").(string)))
 	context := base64.StdEncoding.EncodeToString([]byte(d.Get("context").(string)))
@@ -70,7 +114,7 @@ func transitEncryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 		"key_version": keyVersion,
 	}
 
-	encryptedData, err := client.Logical().Write(backend+"/encrypt/"+key, payload)
+	encryptedData, err := client.Logical().Write(reqPath, payload)
 	if err != nil {
 		return fmt.Errorf("issue encrypting with key: %s", err)
 	}
@@ -23,6 +23,19 @@ func TestDataSourceTransitEncrypt(t *testing.T) {
 				Config: testDataSourceTransitEncrypt_config,
 				Check:  testDataSourceTransitEncrypt_check,
 			},
+			{
+				Config: testDataSourceTransitEncryptBatch_config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_transit_encrypt.test", "batch_results.#", "2"),
+					resource.TestCheckResourceAttrSet("data.vault_transit_encrypt.test", "batch_results.0.ciphertext"),
+					resource.TestCheckResourceAttrSet("data.vault_transit_encrypt.test", "batch_results.1.ciphertext"),
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "batch_results.#", "2"),
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "batch_results.0.plaintext", "foo"),
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "batch_results.0.reference", "first"),
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "batch_results.1.plaintext", "bar"),
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "batch_results.1.reference", "second"),
+				),
+			},
 		},
 	})
 }
@@ -53,6 +66,46 @@ data "vault_transit_decrypt" "test" {
 }
 `
 
+var testDataSourceTransitEncryptBatch_config = `
+resource "vault_mount" "test" {
+  path        = "transit"
+  type        = "transit"
+  description = "This is an example mount"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name             = "test"
+  backend          = vault_mount.test.path
+  deletion_allowed = true
+}
+
+data "vault_transit_encrypt" "test" {
+  backend = vault_mount.test.path
+  key     = vault_transit_secret_backend_key.test.name
+  batch_input = [
+    {
+      plaintext = "foo"
+      reference = "first"
+    },
+    {
+      plaintext = "bar"
+      reference = "second"
+    },
+  ]
+}
+
+data "vault_transit_decrypt" "test" {
+  backend = vault_mount.test.path
+  key     = vault_transit_secret_backend_key.test.name
+  batch_input = [
+    for r in data.vault_transit_encrypt.test.batch_results : {
+      ciphertext = r.ciphertext
+      reference  = r.reference
+    }
+  ]
+}
+`
+
 func testDataSourceTransitEncrypt_check(s *terraform.State) error {
 	resourceState := s.Modules[0].Resources["data.vault_transit_decrypt.test"]
 	if resourceState == nil {
@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+// transitKeyDataSourceIntFields are the numeric fields of a transit key that
+// are returned as json.Number by Vault.
+var transitKeyDataSourceIntFields = []string{
+	consts.FieldLatestVersion,
+	consts.FieldMinAvailableVersion,
+	consts.FieldMinDecryptionVersion,
+	consts.FieldMinEncryptionVersion,
+	consts.FieldAutoRotatePeriod,
+	consts.FieldKeySize,
+}
+
+var transitKeyDataSourceFields = []string{
+	consts.FieldType,
+	consts.FieldSupportsEncryption,
+	consts.FieldSupportsDecryption,
+	consts.FieldSupportsDerivation,
+	consts.FieldSupportsSigning,
+	consts.FieldDeletionAllowed,
+	consts.FieldDerived,
+	consts.FieldExportable,
+	consts.FieldAllowPlaintextBackup,
+	consts.FieldConvergentEncryption,
+}
+
+func transitSecretBackendKeyDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: provider.ReadWrapper(transitSecretBackendKeyDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldPath: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend the key belongs to.",
+			},
+			consts.FieldName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the key.",
+			},
+			consts.FieldType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the key.",
+			},
+			consts.FieldLatestVersion: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Latest key version in use in the keyring.",
+			},
+			consts.FieldMinAvailableVersion: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Minimum key version available for use.",
+			},
+			consts.FieldMinDecryptionVersion: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Minimum key version to use for decryption.",
+			},
+			consts.FieldMinEncryptionVersion: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Minimum key version to use for encryption.",
+			},
+			consts.FieldAutoRotatePeriod: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Amount of seconds the key lives before being automatically rotated.",
+			},
+			consts.FieldKeySize: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The key size in bytes, only set for HMAC keys.",
+			},
+			consts.FieldSupportsEncryption: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether or not the key supports encryption, based on key type.",
+			},
+			consts.FieldSupportsDecryption: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether or not the key supports decryption, based on key type.",
+			},
+			consts.FieldSupportsDerivation: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether or not the key supports derivation, based on key type.",
+			},
+			consts.FieldSupportsSigning: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether or not the key supports signing, based on key type.",
+			},
+			consts.FieldDeletionAllowed: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the key is allowed to be deleted.",
+			},
+			consts.FieldDerived: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether key derivation is used.",
+			},
+			consts.FieldExportable: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the key is exportable.",
+			},
+			consts.FieldAllowPlaintextBackup: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether taking a plaintext backup of the key is allowed.",
+			},
+			consts.FieldConvergentEncryption: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether convergent encryption is enabled for the key.",
+			},
+			consts.FieldKeys: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The key versions in the keyring, ordered by version.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldVersion: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The key version.",
+						},
+						consts.FieldCreationTime: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The creation time of the key version.",
+						},
+						consts.FieldName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the key version, only set for asymmetric keys.",
+						},
+						consts.FieldPublicKey: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The public key of the key version, only set for asymmetric keys.",
+						},
+					},
+				},
+			},
+			consts.FieldPublicKeys: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of key version to public key, only set for asymmetric keys.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func transitSecretBackendKeyDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	path := transitSecretBackendKeyPath(d.Get(consts.FieldPath).(string), d.Get(consts.FieldName).(string))
+
+	log.Printf("[DEBUG] Reading transit key from %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading transit key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read transit key from %q", path)
+
+	if secret == nil {
+		return fmt.Errorf("no transit key found at %q", path)
+	}
+
+	for _, k := range transitKeyDataSourceIntFields {
+		v, ok := secret.Data[k].(json.Number)
+		if !ok {
+			continue
+		}
+		n, err := v.Int64()
+		if err != nil {
+			return fmt.Errorf("expected %s %q to be a number, and it isn't", k, v)
+		}
+		if err := d.Set(k, n); err != nil {
+			return err
+		}
+	}
+
+	for _, k := range transitKeyDataSourceFields {
+		if v, ok := secret.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return err
+			}
+		}
+	}
+
+	keys, publicKeys, err := transitKeyVersionsFromResponse(secret.Data[consts.FieldKeys])
+	if err != nil {
+		return fmt.Errorf("error parsing keys for transit key %q: %s", path, err)
+	}
+	if err := d.Set(consts.FieldKeys, keys); err != nil {
+		return err
+	}
+	if err := d.Set(consts.FieldPublicKeys, publicKeys); err != nil {
+		return err
+	}
+
+	d.SetId(path)
+
+	return nil
+}
+
+// transitKeyVersionsFromResponse flattens the "keys" map returned by Vault into
+// a list ordered by version. The structure of each entry differs depending on
+// the key type: symmetric keys only report the creation time as a unix
+// timestamp, while asymmetric keys report a map that includes the public key.
+func transitKeyVersionsFromResponse(raw interface{}) ([]map[string]interface{}, map[string]string, error) {
+	keys := []map[string]interface{}{}
+	publicKeys := map[string]string{}
+
+	m, ok := raw.(map[string]interface{})
+	if !ok {
+		return keys, publicKeys, nil
+	}
+
+	versions := make([]int, 0, len(m))
+	for k := range m {
+		v, err := strconv.Atoi(k)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unexpected key version %q", k)
+		}
+		versions = append(versions, v)
+	}
+	sort.Ints(versions)
+
+	for _, version := range versions {
+		k := strconv.Itoa(version)
+		key := map[string]interface{}{
+			consts.FieldVersion: version,
+		}
+
+		switch v := m[k].(type) {
+		case json.Number:
+			ts, err := v.Int64()
+			if err != nil {
+				return nil, nil, fmt.Errorf("unexpected creation time %q for key version %s", v, k)
+			}
+			key[consts.FieldCreationTime] = time.Unix(ts, 0).UTC().Format(time.RFC3339)
+		case map[string]interface{}:
+			for _, f := range []string{consts.FieldCreationTime, consts.FieldName, consts.FieldPublicKey} {
+				if s, ok := v[f].(string); ok {
+					key[f] = s
+				}
+			}
+			if pk, ok := v[consts.FieldPublicKey].(string); ok && pk != "" {
+				publicKeys[k] = pk
+			}
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, publicKeys, nil
+}
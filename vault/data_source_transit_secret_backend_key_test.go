@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceTransitSecretBackendKey(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	ds := "data.vault_transit_secret_backend_key.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitSecretBackendKeyConfig(backend, "aes256-gcm96"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(ds, consts.FieldType, "aes256-gcm96"),
+					resource.TestCheckResourceAttr(ds, consts.FieldLatestVersion, "1"),
+					resource.TestCheckResourceAttr(ds, consts.FieldMinDecryptionVersion, "1"),
+					resource.TestCheckResourceAttr(ds, consts.FieldSupportsEncryption, "true"),
+					resource.TestCheckResourceAttr(ds, consts.FieldSupportsSigning, "false"),
+					resource.TestCheckResourceAttr(ds, consts.FieldKeys+".#", "1"),
+					resource.TestCheckResourceAttr(ds, consts.FieldKeys+".0.version", "1"),
+					resource.TestCheckResourceAttrSet(ds, consts.FieldKeys+".0.creation_time"),
+					resource.TestCheckResourceAttr(ds, consts.FieldPublicKeys+".%", "0"),
+				),
+			},
+			{
+				Config: testDataSourceTransitSecretBackendKeyConfig(backend, "ecdsa-p256"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(ds, consts.FieldType, "ecdsa-p256"),
+					resource.TestCheckResourceAttr(ds, consts.FieldSupportsSigning, "true"),
+					resource.TestCheckResourceAttr(ds, consts.FieldKeys+".#", "1"),
+					resource.TestCheckResourceAttrSet(ds, consts.FieldKeys+".0.public_key"),
+					resource.TestCheckResourceAttrSet(ds, consts.FieldPublicKeys+".1"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitSecretBackendKeyConfig(backend, keyType string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend          = vault_mount.test.path
+  name             = "test-%s"
+  type             = "%s"
+  deletion_allowed = true
+}
+
+data "vault_transit_secret_backend_key" "test" {
+  path = vault_mount.test.path
+  name = vault_transit_secret_backend_key.test.name
+}
+`, backend, keyType, keyType)
+}
+
+func TestTransitKeyVersionsFromResponse(t *testing.T) {
+	tests := []struct {
+		name           string
+		raw            interface{}
+		wantKeys       []map[string]interface{}
+		wantPublicKeys map[string]string
+		wantErr        bool
+	}{
+		{
+			name:           "nil",
+			raw:            nil,
+			wantKeys:       []map[string]interface{}{},
+			wantPublicKeys: map[string]string{},
+		},
+		{
+			name: "symmetric",
+			raw: map[string]interface{}{
+				"10": json.Number("1700000100"),
+				"2":  json.Number("1700000000"),
+			},
+			wantKeys: []map[string]interface{}{
+				{
+					consts.FieldVersion:      2,
+					consts.FieldCreationTime: "2023-11-14T22:13:20Z",
+				},
+				{
+					consts.FieldVersion:      10,
+					consts.FieldCreationTime: "2023-11-14T22:15:00Z",
+				},
+			},
+			wantPublicKeys: map[string]string{},
+		},
+		{
+			name: "asymmetric",
+			raw: map[string]interface{}{
+				"1": map[string]interface{}{
+					consts.FieldCreationTime: "2023-11-14T22:13:20Z",
+					consts.FieldName:         "P-256",
+					consts.FieldPublicKey:    "pem",
+				},
+			},
+			wantKeys: []map[string]interface{}{
+				{
+					consts.FieldVersion:      1,
+					consts.FieldCreationTime: "2023-11-14T22:13:20Z",
+					consts.FieldName:         "P-256",
+					consts.FieldPublicKey:    "pem",
+				},
+			},
+			wantPublicKeys: map[string]string{
+				"1": "pem",
+			},
+		},
+		{
+			name: "invalid-version",
+			raw: map[string]interface{}{
+				"latest": json.Number("1700000000"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, publicKeys, err := transitKeyVersionsFromResponse(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("transitKeyVersionsFromResponse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(keys, tt.wantKeys) {
+				t.Errorf("transitKeyVersionsFromResponse() keys = %v, want %v", keys, tt.wantKeys)
+			}
+			if !reflect.DeepEqual(publicKeys, tt.wantPublicKeys) {
+				t.Errorf("transitKeyVersionsFromResponse() publicKeys = %v, want %v", publicKeys, tt.wantPublicKeys)
+			}
+		})
+	}
+}
@@ -149,10 +149,15 @@ func transitSignDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(reqPath)
 
-	batchResults, batchOK := resp.Data[consts.FieldBatchResults]
+	rawBatchResults, batchOK := resp.Data[consts.FieldBatchResults]
 	sig, sigOK := resp.Data[consts.FieldSignature]
 
 	if batchOK {
+		batchResults, err := convertBatchResults(rawBatchResults)
+		if err != nil {
+			return err
+		}
+
 		err = d.Set(consts.FieldBatchResults, batchResults)
 		if err != nil {
 			return err
@@ -8,11 +8,18 @@ import (
 	"io"
 	"log"
 	"path"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 )
 
+// kvListPageSize is the number of names requested per page when listing.
+const kvListPageSize = 1000
+
 func versionedSecret(requestedVersion int, path string, client *api.Client) (*api.Secret, error) {
 	mountPath, v2, err := isKVv2(path, client)
 	if err != nil {
@@ -81,27 +88,103 @@ func kvReadRequest(client *api.Client, path string, params map[string]string) (*
 	return api.ParseSecret(resp.Body)
 }
 
-func kvListRequest(client *api.Client, path string) ([]interface{}, error) {
+// kvListRequest lists the names at path, keeping the names that match the
+// nameRegex. The listing is read in pages of kvListPageSize names, each page is
+// filtered before the next one is requested, so that large listings are not
+// held in memory in full.
+func kvListRequest(client *api.Client, path, nameRegex string) ([]interface{}, error) {
 	log.Printf("[DEBUG] Listing secrets at %s from Vault", path)
-	resp, err := client.Logical().List(path)
-	if err != nil {
-		return nil, fmt.Errorf("error listing from Vault at path %q, err=%s", path, err)
-	}
 
-	if resp == nil {
-		return nil, fmt.Errorf("no secrets found at %q", path)
-	}
+	var names []interface{}
+	var after string
+	for {
+		params := map[string][]string{
+			"list":  {"true"},
+			"limit": {strconv.Itoa(kvListPageSize)},
+		}
+		if after != "" {
+			params["after"] = []string{after}
+		}
+
+		resp, err := client.Logical().ReadWithData(path, params)
+		if err != nil {
+			return nil, fmt.Errorf("error listing from Vault at path %q, err=%s", path, err)
+		}
+
+		if resp == nil {
+			if after != "" {
+				break
+			}
+			return nil, fmt.Errorf("no secrets found at %q", path)
+		}
+
+		keyNameList, ok := resp.Data["keys"]
+		if !ok || keyNameList == nil {
+			if after != "" {
+				break
+			}
+			return nil, fmt.Errorf("no keys present in response from Vault")
+		}
 
-	// Return key names if they are present in response
-	if keyNameList, ok := resp.Data["keys"]; ok && keyNameList != nil {
 		keyNames, ok := keyNameList.([]interface{})
 		if !ok {
 			return nil, fmt.Errorf("keys are incorrectly formatted in response from Vault")
 		}
-		return keyNames, nil
+
+		// Vault versions that do not support pagination return the whole
+		// listing for every request, skip the names that were already read
+		page := keyNames[:0]
+		for _, k := range keyNames {
+			name, ok := k.(string)
+			if !ok {
+				return nil, fmt.Errorf("keys are incorrectly formatted in response from Vault")
+			}
+			if after == "" || name > after {
+				page = append(page, k)
+			}
+		}
+
+		if len(page) == 0 {
+			break
+		}
+		after = page[len(page)-1].(string)
+		count := len(page)
+
+		page, err = filterKVNames(page, nameRegex)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, page...)
+
+		if count < kvListPageSize {
+			break
+		}
+	}
+
+	return names, nil
+}
+
+// filterKVNames returns the listed names that match the regular expression,
+// all names are returned if the expression is empty. The filtered names reuse
+// the backing array of names, so names must not be used afterwards.
+func filterKVNames(names []interface{}, expr string) ([]interface{}, error) {
+	if expr == "" {
+		return names, nil
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %q %q, err=%w", consts.FieldNameRegex, expr, err)
+	}
+
+	filtered := names[:0]
+	for _, n := range names {
+		if name, ok := n.(string); ok && re.MatchString(name) {
+			filtered = append(filtered, n)
+		}
 	}
 
-	return nil, fmt.Errorf("no keys present in response from Vault")
+	return filtered, nil
 }
 
 func kvPreflightVersionRequest(client *api.Client, path string) (string, int, error) {
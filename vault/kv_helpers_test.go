@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestFilterKVNames(t *testing.T) {
+	names := []interface{}{"foo", "foo/", "bar", "baz/"}
+
+	got, err := filterKVNames(append([]interface{}{}, names...), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(names, got) {
+		t.Fatalf("filterKVNames() expected %#v, actual %#v", names, got)
+	}
+
+	got, err = filterKVNames(append([]interface{}{}, names...), "^ba")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []interface{}{"bar", "baz/"}; !reflect.DeepEqual(want, got) {
+		t.Fatalf("filterKVNames() expected %#v, actual %#v", want, got)
+	}
+
+	if _, err := filterKVNames(names, "["); err == nil {
+		t.Fatalf("filterKVNames() expected an error for an invalid expression")
+	}
+}
+
+func TestKVListRequest(t *testing.T) {
+	tests := []struct {
+		name         string
+		keys         int
+		paginated    bool
+		nameRegex    string
+		wantNames    int
+		wantRequests int
+	}{
+		{
+			name:         "single-page",
+			keys:         10,
+			paginated:    true,
+			wantNames:    10,
+			wantRequests: 1,
+		},
+		{
+			name:         "multiple-pages",
+			keys:         kvListPageSize*2 + 500,
+			paginated:    true,
+			wantNames:    kvListPageSize*2 + 500,
+			wantRequests: 3,
+		},
+		{
+			name:         "full-last-page",
+			keys:         kvListPageSize * 2,
+			paginated:    true,
+			wantNames:    kvListPageSize * 2,
+			wantRequests: 3,
+		},
+		{
+			name:         "multiple-pages-filtered",
+			keys:         kvListPageSize*2 + 500,
+			paginated:    true,
+			nameRegex:    "0$",
+			wantNames:    (kvListPageSize*2 + 500) / 10,
+			wantRequests: 3,
+		},
+		{
+			name:         "pagination-unsupported",
+			keys:         kvListPageSize + 500,
+			wantNames:    kvListPageSize + 500,
+			wantRequests: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys := make([]string, 0, tt.keys)
+			for i := 0; i < tt.keys; i++ {
+				keys = append(keys, fmt.Sprintf("key-%05d", i))
+			}
+
+			var requests int
+			config, ln := testutil.TestHTTPServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				q := r.URL.Query()
+				if q.Get("list") != "true" {
+					t.Errorf("expected a list request, got query %q", r.URL.RawQuery)
+				}
+
+				page := keys
+				if tt.paginated {
+					after := q.Get("after")
+					start := sort.SearchStrings(keys, after)
+					if start < len(keys) && keys[start] == after {
+						start++
+					}
+					page = keys[start:]
+					if limit, err := strconv.Atoi(q.Get("limit")); err == nil && limit < len(page) {
+						page = page[:limit]
+					}
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"data": map[string]interface{}{
+						"keys": page,
+					},
+				})
+			}))
+			defer ln.Close()
+
+			client, err := api.NewClient(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			names, err := kvListRequest(client, "kvv2/metadata", tt.nameRegex)
+			if err != nil {
+				t.Fatalf("kvListRequest() unexpected error = %v", err)
+			}
+
+			if len(names) != tt.wantNames {
+				t.Errorf("expected %d names, actual %d", tt.wantNames, len(names))
+			}
+			if requests != tt.wantRequests {
+				t.Errorf("expected %d requests, actual %d", tt.wantRequests, requests)
+			}
+
+			seen := make(map[interface{}]bool, len(names))
+			for _, n := range names {
+				if seen[n] {
+					t.Fatalf("duplicate name %q", n)
+				}
+				seen[n] = true
+			}
+		})
+	}
+}
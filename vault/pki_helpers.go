@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+// pkiSecretBackendDisableConfig writes data to the PKI config at the
+// resource's ID to disable it, for configs that have no delete API. The name
+// of the config is used in logs and errors. A 404 is ignored, since the mount
+// and the config along with it were already removed.
+func pkiSecretBackendDisableConfig(ctx context.Context, d *schema.ResourceData, meta interface{}, name string, data map[string]interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Disabling %s config on PKI secret path %q", name, path)
+	if _, err := client.Logical().WriteWithContext(ctx, path, data); err != nil {
+		if util.Is404(err) {
+			return nil
+		}
+		return diag.Errorf("error disabling %s config on PKI secret path %q: %s", name, path, err)
+	}
+	log.Printf("[DEBUG] Disabled %s config on PKI secret path %q", name, path)
+
+	return nil
+}
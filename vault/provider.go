@@ -73,6 +73,7 @@ var (
 			Resource:       UpdateSchemaResource(namespaceDataSource()),
 			PathInventory:  []string{"/sys/namespaces/{path}"},
 			EnterpriseOnly: true,
+			Capability:     provider.CapabilityNamespaces,
 		},
 		"vault_ad_access_credentials": {
 			Resource:      UpdateSchemaResource(adAccessCredentialsDataSource()),
@@ -82,6 +83,7 @@ var (
 			Resource:       UpdateSchemaResource(namespacesDataSource()),
 			PathInventory:  []string{"/sys/namespaces"},
 			EnterpriseOnly: true,
+			Capability:     provider.CapabilityNamespaces,
 		},
 		"vault_nomad_access_token": {
 			Resource:      UpdateSchemaResource(nomadAccessCredentialsDataSource()),
@@ -159,6 +161,22 @@ var (
 			Resource:      UpdateSchemaResource(kvSecretListDataSourceV2()),
 			PathInventory: []string{"/secret/metadata/{path}/?list=true"},
 		},
+		"vault_kv_mount_config": {
+			Resource:      UpdateSchemaResource(kvMountConfigDataSource()),
+			PathInventory: []string{"/secret/config"},
+		},
+		"vault_kv_secret_exists": {
+			Resource:      UpdateSchemaResource(kvSecretExistsDataSource()),
+			PathInventory: []string{"/secret/metadata/{path}"},
+		},
+		"vault_kv_secret_versions": {
+			Resource:      UpdateSchemaResource(kvSecretVersionsDataSource()),
+			PathInventory: []string{"/secret/data/{path}/?version={version}}"},
+		},
+		"vault_kv_secret_tree": {
+			Resource:      UpdateSchemaResource(kvSecretTreeDataSource()),
+			PathInventory: []string{"/secret/metadata/{path}/?list=true"},
+		},
 		"vault_kv_secret_subkeys_v2": {
 			Resource:      UpdateSchemaResource(kvSecretSubkeysV2DataSource()),
 			PathInventory: []string{"/secret/subkeys/{path}"},
@@ -167,6 +185,31 @@ var (
 			Resource:      UpdateSchemaResource(raftAutopilotStateDataSource()),
 			PathInventory: []string{"/sys/storage/raft/autopilot/state"},
 		},
+		"vault_license_status": {
+			Resource:       UpdateSchemaResource(licenseStatusDataSource()),
+			PathInventory:  []string{"/sys/license/status"},
+			EnterpriseOnly: true,
+		},
+		"vault_ha_status": {
+			Resource:      UpdateSchemaResource(haStatusDataSource()),
+			PathInventory: []string{"/sys/ha-status", "/sys/leader"},
+		},
+		"vault_client_count_activity": {
+			Resource:      UpdateSchemaResource(clientCountActivityDataSource()),
+			PathInventory: []string{"/sys/internal/counters/activity"},
+		},
+		"vault_hash": {
+			Resource:      UpdateSchemaResource(hashDataSource()),
+			PathInventory: []string{"/sys/tools/hash/{urlalgorithm}"},
+		},
+		"vault_pki_secret_backend_cert": {
+			Resource:      UpdateSchemaResource(pkiSecretBackendCertDataSource()),
+			PathInventory: []string{"/pki/cert/{serial}"},
+		},
+		"vault_pki_secret_backend_certs": {
+			Resource:      UpdateSchemaResource(pkiSecretBackendCertsDataSource()),
+			PathInventory: []string{"/pki/certs"},
+		},
 		"vault_pki_secret_backend_cert_metadata": {
 			Resource:      UpdateSchemaResource(pkiSecretBackendCertMetadataDataSource()),
 			PathInventory: []string{"/pki/cert-metadata/{serial}"},
@@ -187,6 +230,14 @@ var (
 			Resource:      UpdateSchemaResource(pkiSecretBackendIssuerDataSource()),
 			PathInventory: []string{"/pki/issuer/{issuer_ref}"},
 		},
+		"vault_pki_secret_backend_ca_chain": {
+			Resource:      UpdateSchemaResource(pkiSecretBackendCAChainDataSource()),
+			PathInventory: []string{"/pki/issuer/{issuer_ref}/json"},
+		},
+		"vault_pki_secret_backend_crl": {
+			Resource:      UpdateSchemaResource(pkiSecretBackendCRLDataSource()),
+			PathInventory: []string{"/pki/issuer/{issuer_ref}/crl", "/pki/issuer/{issuer_ref}/crl/delta"},
+		},
 		"vault_pki_secret_backend_issuers": {
 			Resource:      UpdateSchemaResource(pkiSecretBackendIssuersDataSource()),
 			PathInventory: []string{"/pki/issuers"},
@@ -203,13 +254,21 @@ var (
 			Resource:      UpdateSchemaResource(sshSecretBackendSignDataSource()),
 			PathInventory: []string{"/ssh/sign"},
 		},
+		"vault_ssh_secret_backend_public_key": {
+			Resource:      UpdateSchemaResource(sshSecretBackendPublicKeyDataSource()),
+			PathInventory: []string{"/ssh/config/ca"},
+		},
 		"vault_transform_encode": {
-			Resource:      UpdateSchemaResource(transformEncodeDataSource()),
-			PathInventory: []string{"/transform/encode/{role_name}"},
+			Resource:       UpdateSchemaResource(transformEncodeDataSource()),
+			PathInventory:  []string{"/transform/encode/{role_name}"},
+			EnterpriseOnly: true,
+			Capability:     provider.CapabilityTransform,
 		},
 		"vault_transform_decode": {
-			Resource:      UpdateSchemaResource(transformDecodeDataSource()),
-			PathInventory: []string{"/transform/decode/{role_name}"},
+			Resource:       UpdateSchemaResource(transformDecodeDataSource()),
+			PathInventory:  []string{"/transform/decode/{role_name}"},
+			EnterpriseOnly: true,
+			Capability:     provider.CapabilityTransform,
 		},
 		"vault_transit_sign": {
 			Resource:      UpdateSchemaResource(transitSignDataSource()),
@@ -223,6 +282,10 @@ var (
 			Resource:      UpdateSchemaResource(transitCMACDataSource()),
 			PathInventory: []string{"/transit/cmac/{name}/{url_mac_length}"},
 		},
+		"vault_transit_secret_backend_key": {
+			Resource:      UpdateSchemaResource(transitSecretBackendKeyDataSource()),
+			PathInventory: []string{"/transit/keys/{name}"},
+		},
 	}
 
 	ResourceRegistry = map[string]*provider.Description{
@@ -285,6 +348,10 @@ var (
 			Resource:      UpdateSchemaResource(awsAuthBackendConfigIdentityResource()),
 			PathInventory: []string{"/auth/aws/config/identity"},
 		},
+		"vault_aws_auth_backend_identity_accesslist": {
+			Resource:      UpdateSchemaResource(awsAuthBackendIdentityAccessListResource()),
+			PathInventory: []string{"/auth/aws/config/tidy/identity-accesslist"},
+		},
 		"vault_aws_auth_backend_identity_whitelist": {
 			Resource:      UpdateSchemaResource(awsAuthBackendIdentityWhitelistResource()),
 			PathInventory: []string{"/auth/aws/config/tidy/identity-whitelist"},
@@ -301,6 +368,10 @@ var (
 			Resource:      UpdateSchemaResource(awsAuthBackendRoleTagResource()),
 			PathInventory: []string{"/auth/aws/role/{role}/tag"},
 		},
+		"vault_aws_auth_backend_roletag_denylist": {
+			Resource:      UpdateSchemaResource(awsAuthBackendRoleTagDenyListResource()),
+			PathInventory: []string{"/auth/aws/config/tidy/roletag-denylist"},
+		},
 		"vault_aws_auth_backend_roletag_blacklist": {
 			Resource:      UpdateSchemaResource(awsAuthBackendRoleTagBlacklistResource()),
 			PathInventory: []string{"/auth/aws/config/tidy/roletag-blacklist"},
@@ -497,11 +568,13 @@ var (
 			Resource:       UpdateSchemaResource(egpPolicyResource()),
 			PathInventory:  []string{"/sys/policies/egp/{name}"},
 			EnterpriseOnly: true,
+			Capability:     provider.CapabilitySentinel,
 		},
 		"vault_rgp_policy": {
 			Resource:       UpdateSchemaResource(rgpPolicyResource()),
 			PathInventory:  []string{"/sys/policies/rgp/{name}"},
 			EnterpriseOnly: true,
+			Capability:     provider.CapabilitySentinel,
 		},
 		"vault_mfa_duo": {
 			Resource:       UpdateSchemaResource(mfaDuoResource()),
@@ -531,6 +604,7 @@ var (
 			Resource:       UpdateSchemaResource(namespaceResource()),
 			PathInventory:  []string{"/sys/namespaces/{path}"},
 			EnterpriseOnly: true,
+			Capability:     provider.CapabilityNamespaces,
 		},
 		"vault_audit": {
 			Resource:      UpdateSchemaResource(auditResource()),
@@ -611,6 +685,10 @@ var (
 			Resource:      UpdateSchemaResource(pkiSecretBackendCertResource()),
 			PathInventory: []string{"/pki/issue/{role}"},
 		},
+		"vault_pki_secret_backend_cert_revocation": {
+			Resource:      UpdateSchemaResource(pkiSecretBackendCertRevocationResource()),
+			PathInventory: []string{"/pki/revoke", "/pki/revoke-with-key"},
+		},
 		"vault_pki_secret_backend_crl_config": {
 			Resource:      UpdateSchemaResource(pkiSecretBackendCrlConfigResource()),
 			PathInventory: []string{"/pki/config/crl"},
@@ -671,6 +749,10 @@ var (
 			Resource:      UpdateSchemaResource(pkiSecretBackendSignResource()),
 			PathInventory: []string{"/pki/sign/{role}"},
 		},
+		"vault_pki_secret_backend_sign_verbatim": {
+			Resource:      UpdateSchemaResource(pkiSecretBackendSignVerbatimResource()),
+			PathInventory: []string{"/pki/sign-verbatim", "/pki/sign-verbatim/{role}", "/pki/issuer/{issuer_ref}/sign-verbatim/{role}"},
+		},
 		"vault_pki_secret_backend_key": {
 			Resource:      UpdateSchemaResource(pkiSecretBackendKeyResource()),
 			PathInventory: []string{"/pki/key/{key_id}"},
@@ -679,6 +761,10 @@ var (
 			Resource:      UpdateSchemaResource(pkiSecretBackendIssuerResource()),
 			PathInventory: []string{"/pki/issuer/{issuer_ref}"},
 		},
+		"vault_pki_secret_backend_issuer_cross_sign": {
+			Resource:      UpdateSchemaResource(pkiSecretBackendIssuerCrossSignResource()),
+			PathInventory: []string{"/pki/issuer/{issuer_ref}/sign-intermediate"},
+		},
 		"vault_pki_secret_backend_config_issuers": {
 			Resource:      UpdateSchemaResource(pkiSecretBackendConfigIssuers()),
 			PathInventory: []string{"/pki/config/issuers"},
@@ -688,8 +774,9 @@ var (
 			PathInventory: []string{"/pki/acme/new-eab"},
 		},
 		"vault_quota_lease_count": {
-			Resource:      UpdateSchemaResource(quotaLeaseCountResource()),
-			PathInventory: []string{"/sys/quotas/lease-count/{name}"},
+			Resource:       UpdateSchemaResource(quotaLeaseCountResource()),
+			PathInventory:  []string{"/sys/quotas/lease-count/{name}"},
+			EnterpriseOnly: true,
 		},
 		"vault_quota_rate_limit": {
 			Resource:      UpdateSchemaResource(quotaRateLimitResource()),
@@ -711,29 +798,40 @@ var (
 			Resource:      UpdateSchemaResource(transitSecretBackendKeyResource()),
 			PathInventory: []string{"/transit/keys/{name}"},
 		},
+		"vault_transit_secret_backend_key_import": {
+			Resource:      UpdateSchemaResource(transitSecretBackendKeyImportResource()),
+			PathInventory: []string{"/transit/keys/{name}/import", "/transit/wrapping_key"},
+		},
 		"vault_transit_secret_cache_config": {
 			Resource:      UpdateSchemaResource(transitSecretBackendCacheConfig()),
 			PathInventory: []string{"/transit/cache-config"},
 		},
 		"vault_raft_snapshot_agent_config": {
-			Resource:      UpdateSchemaResource(raftSnapshotAgentConfigResource()),
-			PathInventory: []string{"/sys/storage/raft/snapshot-auto/config/{name}"},
+			Resource:       UpdateSchemaResource(raftSnapshotAgentConfigResource()),
+			PathInventory:  []string{"/sys/storage/raft/snapshot-auto/config/{name}"},
+			EnterpriseOnly: true,
 		},
 		"vault_raft_autopilot": {
 			Resource:      UpdateSchemaResource(raftAutopilotConfigResource()),
 			PathInventory: []string{"/sys/storage/raft/autopilot/configuration"},
 		},
 		"vault_kmip_secret_backend": {
-			Resource:      UpdateSchemaResource(kmipSecretBackendResource()),
-			PathInventory: []string{"/kmip/config"},
+			Resource:       UpdateSchemaResource(kmipSecretBackendResource()),
+			PathInventory:  []string{"/kmip/config"},
+			EnterpriseOnly: true,
+			Capability:     provider.CapabilityKMIP,
 		},
 		"vault_kmip_secret_scope": {
-			Resource:      UpdateSchemaResource(kmipSecretScopeResource()),
-			PathInventory: []string{"/kmip/scope/{scope}"},
+			Resource:       UpdateSchemaResource(kmipSecretScopeResource()),
+			PathInventory:  []string{"/kmip/scope/{scope}"},
+			EnterpriseOnly: true,
+			Capability:     provider.CapabilityKMIP,
 		},
 		"vault_kmip_secret_role": {
-			Resource:      UpdateSchemaResource(kmipSecretRoleResource()),
-			PathInventory: []string{"/kmip/scope/{scope}/role/{role}"},
+			Resource:       UpdateSchemaResource(kmipSecretRoleResource()),
+			PathInventory:  []string{"/kmip/scope/{scope}/role/{role}"},
+			EnterpriseOnly: true,
+			Capability:     provider.CapabilityKMIP,
 		},
 		"vault_mongodbatlas_secret_backend": {
 			Resource:      UpdateSchemaResource(mongodbAtlasSecretBackendResource()),
@@ -780,24 +878,33 @@ var (
 			PathInventory: []string{"/kubernetes/roles/{name}"},
 		},
 		"vault_managed_keys": {
-			Resource:      UpdateSchemaResource(managedKeysResource()),
-			PathInventory: []string{"/sys/managed-keys/{type}/{name}"},
+			Resource:       UpdateSchemaResource(managedKeysResource()),
+			PathInventory:  []string{"/sys/managed-keys/{type}/{name}"},
+			EnterpriseOnly: true,
 		},
 		"vault_transform_transformation": {
-			Resource:      UpdateSchemaResource(transformTransformationResource()),
-			PathInventory: []string{"/transform/transformation/{name}"},
+			Resource:       UpdateSchemaResource(transformTransformationResource()),
+			PathInventory:  []string{"/transform/transformation/{name}"},
+			EnterpriseOnly: true,
+			Capability:     provider.CapabilityTransform,
 		},
 		"vault_transform_template": {
-			Resource:      UpdateSchemaResource(transformTemplateResource()),
-			PathInventory: []string{"/transform/template/{name}"},
+			Resource:       UpdateSchemaResource(transformTemplateResource()),
+			PathInventory:  []string{"/transform/template/{name}"},
+			EnterpriseOnly: true,
+			Capability:     provider.CapabilityTransform,
 		},
 		"vault_transform_role": {
-			Resource:      UpdateSchemaResource(transformRoleResource()),
-			PathInventory: []string{"/transform/role/{name}"},
+			Resource:       UpdateSchemaResource(transformRoleResource()),
+			PathInventory:  []string{"/transform/role/{name}"},
+			EnterpriseOnly: true,
+			Capability:     provider.CapabilityTransform,
 		},
 		"vault_transform_alphabet": {
-			Resource:      UpdateSchemaResource(transformAlphabetResource()),
-			PathInventory: []string{"/transform/alphabet/{name}"},
+			Resource:       UpdateSchemaResource(transformAlphabetResource()),
+			PathInventory:  []string{"/transform/alphabet/{name}"},
+			EnterpriseOnly: true,
+			Capability:     provider.CapabilityTransform,
 		},
 		"vault_saml_auth_backend": {
 			Resource:      UpdateSchemaResource(samlAuthBackendResource()),
@@ -808,41 +915,59 @@ var (
 			PathInventory: []string{"/auth/saml/role/{name}"},
 		},
 		"vault_secrets_sync_config": {
-			Resource:      UpdateSchemaResource(secretsSyncConfigResource()),
-			PathInventory: []string{"/sys/sync/config"},
+			Resource:       UpdateSchemaResource(secretsSyncConfigResource()),
+			PathInventory:  []string{"/sys/sync/config"},
+			EnterpriseOnly: true,
 		},
 		"vault_secrets_sync_aws_destination": {
-			Resource:      UpdateSchemaResource(awsSecretsSyncDestinationResource()),
-			PathInventory: []string{"/sys/sync/destinations/aws-sm/{name}"},
+			Resource:       UpdateSchemaResource(awsSecretsSyncDestinationResource()),
+			PathInventory:  []string{"/sys/sync/destinations/aws-sm/{name}"},
+			EnterpriseOnly: true,
 		},
 		"vault_secrets_sync_azure_destination": {
-			Resource:      UpdateSchemaResource(azureSecretsSyncDestinationResource()),
-			PathInventory: []string{"/sys/sync/destinations/azure-kv/{name}"},
+			Resource:       UpdateSchemaResource(azureSecretsSyncDestinationResource()),
+			PathInventory:  []string{"/sys/sync/destinations/azure-kv/{name}"},
+			EnterpriseOnly: true,
 		},
 		"vault_secrets_sync_gcp_destination": {
-			Resource:      UpdateSchemaResource(gcpSecretsSyncDestinationResource()),
-			PathInventory: []string{"/sys/sync/destinations/gcp-sm/{name}"},
+			Resource:       UpdateSchemaResource(gcpSecretsSyncDestinationResource()),
+			PathInventory:  []string{"/sys/sync/destinations/gcp-sm/{name}"},
+			EnterpriseOnly: true,
 		},
 		"vault_secrets_sync_gh_destination": {
-			Resource:      UpdateSchemaResource(githubSecretsSyncDestinationResource()),
-			PathInventory: []string{"/sys/sync/destinations/gh/{name}"},
+			Resource:       UpdateSchemaResource(githubSecretsSyncDestinationResource()),
+			PathInventory:  []string{"/sys/sync/destinations/gh/{name}"},
+			EnterpriseOnly: true,
 		},
 		"vault_secrets_sync_github_apps": {
-			Resource:      UpdateSchemaResource(githubAppsSecretsSyncResource()),
-			PathInventory: []string{"/sys/sync/github-apps/{name}"},
+			Resource:       UpdateSchemaResource(githubAppsSecretsSyncResource()),
+			PathInventory:  []string{"/sys/sync/github-apps/{name}"},
+			EnterpriseOnly: true,
 		},
 		"vault_secrets_sync_vercel_destination": {
-			Resource:      UpdateSchemaResource(vercelSecretsSyncDestinationResource()),
-			PathInventory: []string{"/sys/sync/destinations/vercel-project/{name}"},
+			Resource:       UpdateSchemaResource(vercelSecretsSyncDestinationResource()),
+			PathInventory:  []string{"/sys/sync/destinations/vercel-project/{name}"},
+			EnterpriseOnly: true,
 		},
 		"vault_secrets_sync_association": {
-			Resource:      UpdateSchemaResource(secretsSyncAssociationResource()),
-			PathInventory: []string{"/sys/sync/destinations/{type}/{name}/associations/set"},
+			Resource:       UpdateSchemaResource(secretsSyncAssociationResource()),
+			PathInventory:  []string{"/sys/sync/destinations/{type}/{name}/associations/set"},
+			EnterpriseOnly: true,
 		},
 		"vault_config_ui_custom_message": {
 			Resource:      UpdateSchemaResource(configUICustomMessageResource()),
 			PathInventory: []string{"/sys/config/ui/custom-messages"},
 		},
+		"vault_config_ui_header": {
+			// Only available in the root namespace, don't add namespace to the schema.
+			Resource:      configUIHeaderResource(),
+			PathInventory: []string{"/sys/config/ui/headers/{header}"},
+		},
+		"vault_cors_config": {
+			// Only available in the root namespace, don't add namespace to the schema.
+			Resource:      corsConfigResource(),
+			PathInventory: []string{"/sys/config/cors"},
+		},
 		"vault_plugin": {
 			// Only available in the root namespace, don't add namespace to the schema.
 			Resource:      pluginResource(),
@@ -5,16 +5,20 @@ package vault
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"path"
 	"sync"
 	"testing"
 
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -961,3 +965,90 @@ func setupTestTokenHelper(t *testing.T, script string) (cleanup func()) {
 		}
 	}
 }
+
+// testMockVaultMeta returns a provider meta with a client configured against
+// a mock Vault server that serves the token lookup and child token creation,
+// and delegates all other requests to handler.
+func testMockVaultMeta(t *testing.T, handler http.HandlerFunc) interface{} {
+	t.Helper()
+
+	config, ln := testutil.TestHTTPServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/auth/token/lookup-self":
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": map[string]interface{}{
+					"id":  "test-token",
+					"ttl": 3600,
+				},
+			})
+		case "/v1/auth/token/create":
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"auth": map[string]interface{}{
+					"client_token":   "child-token",
+					"lease_duration": 3600,
+				},
+			})
+		default:
+			handler(w, r)
+		}
+	}))
+	t.Cleanup(func() { ln.Close() })
+
+	// disable retries so that server errors are returned immediately
+	t.Setenv("VAULT_MAX_RETRIES", "0")
+
+	providerResource := &schema.Resource{
+		Schema: Provider().Schema,
+	}
+	d := providerResource.TestResourceData()
+	for k, v := range map[string]interface{}{
+		consts.FieldAddress: config.Address,
+		consts.FieldToken:   "test-token",
+	} {
+		if err := d.Set(k, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	meta, err := provider.NewProviderMeta(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return meta
+}
+
+// testResourceApply plans and applies cfg against the prior state,
+// returning the new state.
+func testResourceApply(t *testing.T, r *schema.Resource, prior *sdkterraform.InstanceState, cfg map[string]interface{}, meta interface{}) *sdkterraform.InstanceState {
+	t.Helper()
+
+	// the raw config is not set on legacy diffs, it is required by resources
+	// that inspect it, e.g. to check whether a field is configured.
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rawConfig, err := ctyjson.Unmarshal(b, r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	diff, err := r.Diff(ctx, prior, sdkterraform.NewResourceConfigRaw(cfg), meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil {
+		diff.RawConfig = rawConfig
+	}
+
+	state, diags := r.Apply(ctx, prior, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	return state
+}
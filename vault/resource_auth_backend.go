@@ -113,6 +113,10 @@ func authBackendWrite(ctx context.Context, d *schema.ResourceData, meta interfac
 		return diag.Errorf("error writing to Vault: %s", err)
 	}
 
+	if err := waitForPath(ctx, meta, client, "sys/auth/"+path); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(path)
 
 	return authBackendUpdate(ctx, d, meta)
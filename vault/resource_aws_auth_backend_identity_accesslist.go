@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+var awsAuthBackendIdentityAccessListBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/config/tidy/identity-accesslist$")
+
+func awsAuthBackendIdentityAccessListResource() *schema.Resource {
+	return &schema.Resource{
+		Create: awsAuthBackendIdentityAccessListWrite,
+		Read:   provider.ReadWrapper(awsAuthBackendIdentityAccessListRead),
+		Update: awsAuthBackendIdentityAccessListWrite,
+		Delete: awsAuthBackendIdentityAccessListDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Unique name of the auth backend to configure.",
+				ForceNew:    true,
+				Default:     "aws",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			consts.FieldSafetyBuffer: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The amount of extra time, in seconds, that must have passed beyond the identity expiration, before it's removed from backend storage.",
+			},
+			consts.FieldDisablePeriodicTidy: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If true, disables the periodic tidying of the identity access list entries.",
+			},
+		},
+	}
+}
+
+func awsAuthBackendIdentityAccessListWrite(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	backend := d.Get(consts.FieldBackend).(string)
+	data := map[string]interface{}{
+		consts.FieldDisablePeriodicTidy: d.Get(consts.FieldDisablePeriodicTidy),
+	}
+
+	if v, ok := d.GetOk(consts.FieldSafetyBuffer); ok {
+		data[consts.FieldSafetyBuffer] = v
+	}
+
+	path := awsAuthBackendIdentityAccessListPath(backend)
+
+	log.Printf("[DEBUG] Configuring AWS auth backend identity access list %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error configuring AWS auth backend identity access list %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Configured AWS auth backend identity access list %q", path)
+
+	d.SetId(path)
+
+	return awsAuthBackendIdentityAccessListRead(d, meta)
+}
+
+func awsAuthBackendIdentityAccessListRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	path := d.Id()
+
+	backend, err := awsAuthBackendIdentityAccessListBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for AWS auth backend identity access list: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading identity access list %q from AWS auth backend", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading AWS auth backend identity access list %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read identity access list %q from AWS auth backend", path)
+	if resp == nil {
+		log.Printf("[WARN] AWS auth backend identity access list %q not found, removing it from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(consts.FieldBackend, backend); err != nil {
+		return err
+	}
+
+	for _, k := range []string{consts.FieldSafetyBuffer, consts.FieldDisablePeriodicTidy} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func awsAuthBackendIdentityAccessListDelete(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Removing identity access list %q from AWS auth backend", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting AWS auth backend identity access list %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Removed identity access list %q from AWS auth backend", path)
+
+	return nil
+}
+
+func awsAuthBackendIdentityAccessListPath(backend string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/config/tidy/identity-accesslist"
+}
+
+func awsAuthBackendIdentityAccessListBackendFromPath(path string) (string, error) {
+	if !awsAuthBackendIdentityAccessListBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := awsAuthBackendIdentityAccessListBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
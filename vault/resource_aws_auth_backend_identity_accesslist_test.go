@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccAWSAuthBackendIdentityAccessList_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("aws")
+	resourceName := "vault_aws_auth_backend_identity_accesslist.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAuthBackendIdentityAccessListConfig(backend, 8600, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "auth/"+backend+"/config/tidy/identity-accesslist"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldBackend, backend),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldSafetyBuffer, "8600"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDisablePeriodicTidy, "true"),
+				),
+			},
+			{
+				Config: testAccAWSAuthBackendIdentityAccessListConfig(backend, 3600, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldSafetyBuffer, "3600"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDisablePeriodicTidy, "false"),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil),
+		},
+	})
+}
+
+func testAccAWSAuthBackendIdentityAccessListConfig(backend string, safetyBuffer int, disable bool) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "aws" {
+  path = "%s"
+  type = "aws"
+}
+
+resource "vault_aws_auth_backend_identity_accesslist" "test" {
+  backend               = vault_auth_backend.aws.path
+  safety_buffer         = %d
+  disable_periodic_tidy = %t
+}`, backend, safetyBuffer, disable)
+}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+var awsAuthBackendRoleTagDenyListBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/config/tidy/roletag-denylist$")
+
+func awsAuthBackendRoleTagDenyListResource() *schema.Resource {
+	return &schema.Resource{
+		Create: awsAuthBackendRoleTagDenyListWrite,
+		Read:   provider.ReadWrapper(awsAuthBackendRoleTagDenyListRead),
+		Update: awsAuthBackendRoleTagDenyListWrite,
+		Delete: awsAuthBackendRoleTagDenyListDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Unique name of the auth backend to configure.",
+				ForceNew:    true,
+				Default:     "aws",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			consts.FieldSafetyBuffer: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The amount of extra time, in seconds, that must have passed beyond the roletag expiration, before it's removed from backend storage.",
+			},
+			consts.FieldDisablePeriodicTidy: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If true, disables the periodic tidying of the roletag deny list entries.",
+			},
+		},
+	}
+}
+
+func awsAuthBackendRoleTagDenyListWrite(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	backend := d.Get(consts.FieldBackend).(string)
+	data := map[string]interface{}{
+		consts.FieldDisablePeriodicTidy: d.Get(consts.FieldDisablePeriodicTidy),
+	}
+
+	if v, ok := d.GetOk(consts.FieldSafetyBuffer); ok {
+		data[consts.FieldSafetyBuffer] = v
+	}
+
+	path := awsAuthBackendRoleTagDenyListPath(backend)
+
+	log.Printf("[DEBUG] Configuring AWS auth backend roletag deny list %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error configuring AWS auth backend roletag deny list %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Configured AWS auth backend roletag deny list %q", path)
+
+	d.SetId(path)
+
+	return awsAuthBackendRoleTagDenyListRead(d, meta)
+}
+
+func awsAuthBackendRoleTagDenyListRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	path := d.Id()
+
+	backend, err := awsAuthBackendRoleTagDenyListBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for AWS auth backend roletag deny list: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading roletag deny list %q from AWS auth backend", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading AWS auth backend roletag deny list %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read roletag deny list %q from AWS auth backend", path)
+	if resp == nil {
+		log.Printf("[WARN] AWS auth backend roletag deny list %q not found, removing it from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(consts.FieldBackend, backend); err != nil {
+		return err
+	}
+
+	for _, k := range []string{consts.FieldSafetyBuffer, consts.FieldDisablePeriodicTidy} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func awsAuthBackendRoleTagDenyListDelete(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Removing roletag deny list %q from AWS auth backend", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting AWS auth backend roletag deny list %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Removed roletag deny list %q from AWS auth backend", path)
+
+	return nil
+}
+
+func awsAuthBackendRoleTagDenyListPath(backend string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/config/tidy/roletag-denylist"
+}
+
+func awsAuthBackendRoleTagDenyListBackendFromPath(path string) (string, error) {
+	if !awsAuthBackendRoleTagDenyListBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := awsAuthBackendRoleTagDenyListBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
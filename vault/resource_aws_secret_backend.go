@@ -157,6 +157,22 @@ func awsSecretBackendResource() *schema.Resource {
 				Default:     -1,
 				Description: "Number of max retries the client should use for recoverable errors.",
 			},
+			consts.FieldRotateRootOnCreate: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Rotate the root access key immediately after the backend is configured, " +
+					"so that the configured access key is only known to Vault.",
+			},
+			consts.FieldRotationVersion: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Version counter used to trigger a rotation of the root access key.",
+			},
+			consts.FieldRootRotated: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the root access key has been rotated by Vault.",
+			},
 		},
 	}, false)
 
@@ -179,6 +195,10 @@ func awsSecretBackendResource() *schema.Resource {
 
 func getMountCustomizeDiffFunc(field string) schema.CustomizeDiffFunc {
 	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if err := mountForceNoCacheCustomizeDiff(ctx, diff, meta); err != nil {
+			return err
+		}
+
 		if !diff.HasChange(field) {
 			return nil
 		}
@@ -286,6 +306,17 @@ func awsSecretBackendCreate(ctx context.Context, d *schema.ResourceData, meta in
 	if region == "" {
 		d.Set(consts.FieldRegion, "us-east-1")
 	}
+
+	rotated := false
+	if d.Get(consts.FieldRotateRootOnCreate).(bool) {
+		if err := awsSecretBackendRotateRoot(ctx, client, path); err != nil {
+			return diag.FromErr(err)
+		}
+		rotated = true
+	}
+	if err := d.Set(consts.FieldRootRotated, rotated); err != nil {
+		return diag.FromErr(err)
+	}
 	d.Partial(false)
 
 	return awsSecretBackendRead(ctx, d, meta)
@@ -318,7 +349,9 @@ func awsSecretBackendRead(ctx context.Context, d *schema.ResourceData, meta inte
 		resp = nil
 	}
 	if resp != nil {
-		if v, ok := resp.Data[consts.FieldAccessKey].(string); ok {
+		// once the root credentials have been rotated the access key is only
+		// known to Vault, so it must not be reconciled against the configuration
+		if v, ok := resp.Data[consts.FieldAccessKey].(string); ok && !awsSecretBackendRootRotated(d) {
 			d.Set(consts.FieldAccessKey, v)
 		}
 		// Terrible backwards compatibility hack. Previously, if no region was specified,
@@ -423,9 +456,13 @@ func awsSecretBackendUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		consts.FieldDisableAutomatedRotation,
 	) {
 		log.Printf("[DEBUG] Updating root credentials at %q", path+"/config/root")
-		data := map[string]interface{}{
-			consts.FieldAccessKey: d.Get(consts.FieldAccessKey).(string),
-			consts.FieldSecretKey: d.Get(consts.FieldSecretKey).(string),
+		data := map[string]interface{}{}
+		// avoid overwriting credentials that were rotated by Vault, unless
+		// new credentials have been explicitly configured
+		writeCreds := !awsSecretBackendRootRotated(d) || d.HasChanges(consts.FieldAccessKey, consts.FieldSecretKey)
+		if writeCreds {
+			data[consts.FieldAccessKey] = d.Get(consts.FieldAccessKey).(string)
+			data[consts.FieldSecretKey] = d.Get(consts.FieldSecretKey).(string)
 		}
 
 		for _, k := range awsSecretFields {
@@ -483,11 +520,44 @@ func awsSecretBackendUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		if region == "" {
 			d.Set(consts.FieldRegion, "us-east-1")
 		}
+
+		// the newly configured credentials have not been rotated yet
+		if writeCreds {
+			if err := d.Set(consts.FieldRootRotated, false); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange(consts.FieldRotationVersion) {
+		if err := awsSecretBackendRotateRoot(ctx, client, path); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set(consts.FieldRootRotated, true); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 	d.Partial(false)
 	return awsSecretBackendRead(ctx, d, meta)
 }
 
+// awsSecretBackendRootRotated returns true if the root credentials have
+// been rotated by Vault since they were last written by the provider.
+func awsSecretBackendRootRotated(d *schema.ResourceData) bool {
+	return d.Get(consts.FieldRootRotated).(bool)
+}
+
+func awsSecretBackendRotateRoot(ctx context.Context, client *api.Client, path string) error {
+	rotatePath := path + "/config/rotate-root"
+	log.Printf("[DEBUG] Rotating root credentials at %q", rotatePath)
+	if _, err := client.Logical().WriteWithContext(ctx, rotatePath, nil); err != nil {
+		return fmt.Errorf("error rotating root credentials for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Rotated root credentials at %q", rotatePath)
+
+	return nil
+}
+
 func awsSecretBackendDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, e := provider.GetClient(d, meta)
 	if e != nil {
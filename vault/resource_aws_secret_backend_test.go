@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
@@ -415,6 +416,77 @@ func TestAccAWSSecretBackend_max_retries(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecretBackend_rotateRoot(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-aws")
+	resourceType := "vault_aws_secret_backend"
+	resourceName := resourceType + ".test"
+	// the root credentials are rotated by Vault during this test, so they
+	// must be dedicated IAM user credentials that can be discarded afterwards.
+	v := testutil.SkipTestEnvUnset(t, "AWS_ROTATE_ROOT_ACCESS_KEY_ID", "AWS_ROTATE_ROOT_SECRET_ACCESS_KEY")
+	accessKey, secretKey := v[0], v[1]
+
+	var rotatedAccessKey string
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy:             testCheckMountDestroyed(resourceType, consts.MountTypeAWS, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecretBackendConfig_rotateRoot(path, accessKey, secretKey, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRotateRootOnCreate, "true"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRootRotated, "true"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldAccessKey, accessKey),
+					testAWSSecretBackendCheckAccessKey(path, func(v string) error {
+						if v == accessKey {
+							return fmt.Errorf("expected the root access key to be rotated on create")
+						}
+						rotatedAccessKey = v
+						return nil
+					}),
+				),
+			},
+			{
+				// no changes are expected after the rotation
+				Config:   testAccAWSSecretBackendConfig_rotateRoot(path, accessKey, secretKey, 0),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAWSSecretBackendConfig_rotateRoot(path, accessKey, secretKey, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRotationVersion, "1"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRootRotated, "true"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldAccessKey, accessKey),
+					testAWSSecretBackendCheckAccessKey(path, func(v string) error {
+						if v == accessKey || v == rotatedAccessKey {
+							return fmt.Errorf("expected the root access key to be rotated on rotation_version change")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+// testAWSSecretBackendCheckAccessKey calls check with the root access key
+// that is currently configured in Vault.
+func testAWSSecretBackendCheckAccessKey(path string, check func(string) error) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client := testProvider.Meta().(*provider.ProviderMeta).MustGetClient()
+		resp, err := client.Logical().Read(path + "/config/root")
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			return fmt.Errorf("no root configuration found at %q", path)
+		}
+
+		v, _ := resp.Data[consts.FieldAccessKey].(string)
+		return check(v)
+	}
+}
+
 func testAccAWSSecretBackendConfig_MountConfig(path string, isUpdate bool) string {
 	if !isUpdate {
 
@@ -609,3 +681,14 @@ resource "vault_aws_secret_backend" "test" {
   max_retries = "%d"
 }`, path, accessKey, secretKey, maxRetry)
 }
+
+func testAccAWSSecretBackendConfig_rotateRoot(path, accessKey, secretKey string, rotationVersion int) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "test" {
+  path                  = "%s"
+  access_key            = "%s"
+  secret_key            = "%s"
+  rotate_root_on_create = true
+  rotation_version      = %d
+}`, path, accessKey, secretKey, rotationVersion)
+}
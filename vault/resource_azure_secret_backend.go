@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
@@ -102,6 +103,17 @@ func azureSecretBackendResource() *schema.Resource {
 				Computed:    true,
 				Description: "The TTL in seconds of the root password in Azure when rotate-root generates a new client secret",
 			},
+			consts.FieldRotateRootOnCreate: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Rotate the root client secret immediately after the backend is configured, " +
+					"so that the configured client secret is only known to Vault. Only applies on create.",
+			},
+			consts.FieldRotationVersion: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Version counter used to trigger a rotation of the root client secret.",
+			},
 		},
 	}, false)
 
@@ -143,6 +155,12 @@ func azureSecretBackendCreate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("error writing Azure configuration for %q: %s", path, err)
 	}
 	log.Printf("[DEBUG] Wrote Azure configuration to %q", configPath)
+
+	if d.Get(consts.FieldRotateRootOnCreate).(bool) {
+		if err := azureSecretBackendRotateRoot(ctx, client, path); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	d.Partial(false)
 
 	return azureSecretBackendRead(ctx, d, meta)
@@ -234,6 +252,12 @@ func azureSecretBackendUpdate(ctx context.Context, d *schema.ResourceData, meta
 		log.Printf("[DEBUG] Updated Azure Backend Config at %q", azureSecretBackendPath(path))
 	}
 
+	if d.HasChange(consts.FieldRotationVersion) {
+		if err := azureSecretBackendRotateRoot(ctx, client, path); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return azureSecretBackendRead(ctx, d, meta)
 }
 
@@ -254,6 +278,17 @@ func azureSecretBackendDelete(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
+func azureSecretBackendRotateRoot(ctx context.Context, client *api.Client, path string) error {
+	rotatePath := strings.Trim(path, "/") + "/rotate-root"
+	log.Printf("[DEBUG] Rotating Azure root credentials at %q", rotatePath)
+	if _, err := client.Logical().WriteWithContext(ctx, rotatePath, nil); err != nil {
+		return fmt.Errorf("error rotating Azure root credentials for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Rotated Azure root credentials at %q", rotatePath)
+
+	return nil
+}
+
 func azureSecretBackendPath(path string) string {
 	return strings.Trim(path, "/") + "/config"
 }
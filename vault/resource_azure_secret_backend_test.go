@@ -5,7 +5,9 @@ package vault
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"regexp"
 	"testing"
 
@@ -304,6 +306,126 @@ func TestAccAzureSecretBackendConfig_automatedRotation(t *testing.T) {
 	})
 }
 
+func TestAzureSecretBackendRotateRoot(t *testing.T) {
+	baseConfig := map[string]interface{}{
+		consts.FieldPath:           "azure",
+		consts.FieldSubscriptionID: "11111111-2222-3333-4444-111111111111",
+		consts.FieldTenantID:       "11111111-2222-3333-4444-222222222222",
+		consts.FieldClientID:       "11111111-2222-3333-4444-333333333333",
+		consts.FieldClientSecret:   "12345678901234567890",
+	}
+
+	withConfig := func(extra map[string]interface{}) map[string]interface{} {
+		cfg := make(map[string]interface{}, len(baseConfig)+len(extra))
+		for k, v := range baseConfig {
+			cfg[k] = v
+		}
+		for k, v := range extra {
+			cfg[k] = v
+		}
+		return cfg
+	}
+
+	tests := []struct {
+		name         string
+		create       map[string]interface{}
+		update       map[string]interface{}
+		wantOnCreate bool
+		wantOnUpdate bool
+	}{
+		{
+			name:         "create-without-rotation",
+			create:       withConfig(nil),
+			wantOnCreate: false,
+		},
+		{
+			name: "rotate-root-on-create",
+			create: withConfig(map[string]interface{}{
+				consts.FieldRotateRootOnCreate: true,
+			}),
+			wantOnCreate: true,
+		},
+		{
+			name:   "rotate-root-on-create-after-create",
+			create: withConfig(nil),
+			update: withConfig(map[string]interface{}{
+				consts.FieldRotateRootOnCreate: true,
+				consts.FieldClientID:           "22222222-3333-4444-5555-444444444444",
+			}),
+			wantOnUpdate: false,
+		},
+		{
+			name: "rotation-version-changed",
+			create: withConfig(map[string]interface{}{
+				consts.FieldRotationVersion: 1,
+			}),
+			update: withConfig(map[string]interface{}{
+				consts.FieldRotationVersion: 2,
+			}),
+			wantOnUpdate: true,
+		},
+		{
+			name: "rotation-version-unchanged",
+			create: withConfig(map[string]interface{}{
+				consts.FieldRotationVersion: 1,
+			}),
+			update: withConfig(map[string]interface{}{
+				consts.FieldRotationVersion: 1,
+				consts.FieldClientID:        "22222222-3333-4444-5555-444444444444",
+			}),
+			wantOnUpdate: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rotations int
+			meta := testMockVaultMeta(t, func(w http.ResponseWriter, r *http.Request) {
+				var data map[string]interface{}
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/v1/sys/mounts/azure":
+					data = map[string]interface{}{
+						consts.FieldType: consts.MountTypeAzure,
+					}
+				case r.Method == http.MethodGet && r.URL.Path == "/v1/azure/config":
+					data = map[string]interface{}{
+						consts.FieldSubscriptionID: baseConfig[consts.FieldSubscriptionID],
+						consts.FieldTenantID:       baseConfig[consts.FieldTenantID],
+						consts.FieldClientID:       baseConfig[consts.FieldClientID],
+					}
+				case r.URL.Path == "/v1/azure/rotate-root":
+					rotations++
+				}
+
+				if data == nil {
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"data": data,
+				})
+			})
+
+			r := azureSecretBackendResource()
+			state := testResourceApply(t, r, nil, tt.create, meta)
+			if got := rotations > 0; got != tt.wantOnCreate {
+				t.Fatalf("rotated on create = %v, want %v", got, tt.wantOnCreate)
+			}
+
+			if tt.update == nil {
+				return
+			}
+
+			rotations = 0
+			testResourceApply(t, r, state, tt.update, meta)
+			if got := rotations > 0; got != tt.wantOnUpdate {
+				t.Fatalf("rotated on update = %v, want %v", got, tt.wantOnUpdate)
+			}
+		})
+	}
+}
+
 func testAzureSecretBackend_initialConfig(path string) string {
 	return fmt.Sprintf(`
 resource "vault_azure_secret_backend" "test" {
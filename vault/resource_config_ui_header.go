@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const configUIHeaderPathPrefix = "sys/config/ui/headers/"
+
+func configUIHeaderPath(name string) string {
+	return configUIHeaderPathPrefix + name
+}
+
+func configUIHeaderResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: configUIHeaderWrite,
+		UpdateContext: configUIHeaderWrite,
+		ReadContext:   provider.ReadContextWrapper(configUIHeaderRead),
+		DeleteContext: configUIHeaderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the header served by the Vault UI, e.g. Content-Security-Policy.",
+			},
+			consts.FieldValues: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The values of the header.",
+			},
+		},
+	}
+}
+
+func configUIHeaderWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	name := d.Get(consts.FieldName).(string)
+	path := configUIHeaderPath(name)
+
+	log.Printf("[DEBUG] Writing UI header %q", name)
+	if _, err := client.Logical().WriteWithContext(ctx, path, map[string]interface{}{
+		consts.FieldValues: d.Get(consts.FieldValues),
+	}); err != nil {
+		return diag.Errorf("error writing UI header %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Wrote UI header %q", name)
+
+	d.SetId(name)
+
+	return configUIHeaderRead(ctx, d, meta)
+}
+
+func configUIHeaderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	name := d.Id()
+
+	log.Printf("[DEBUG] Reading UI header %q", name)
+	// multivalue returns all values of the header instead of only the first one
+	resp, err := client.Logical().ReadWithDataWithContext(ctx, configUIHeaderPath(name), map[string][]string{
+		"multivalue": {"true"},
+	})
+	if err != nil {
+		return diag.Errorf("error reading UI header %q: %s", name, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] UI header %q not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(consts.FieldName, name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(consts.FieldValues, resp.Data[consts.FieldValues]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func configUIHeaderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	name := d.Id()
+
+	log.Printf("[DEBUG] Deleting UI header %q", name)
+	if _, err := client.Logical().DeleteWithContext(ctx, configUIHeaderPath(name)); err != nil {
+		return diag.Errorf("error deleting UI header %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Deleted UI header %q", name)
+
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestConfigUIHeader(t *testing.T) {
+	name := "X-Tf-Test-Header"
+	resourceName := "vault_config_ui_header.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy:             testConfigUIHeaderCheckDestroy(name),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfigUIHeader_basic(name, `"default-src 'self'"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldName, name),
+					resource.TestCheckResourceAttr(resourceName, "values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "values.0", "default-src 'self'"),
+				),
+			},
+			{
+				Config: testConfigUIHeader_basic(name, `"value1", "value2"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldName, name),
+					resource.TestCheckResourceAttr(resourceName, "values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "values.0", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "values.1", "value2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testConfigUIHeaderCheckDestroy(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*provider.ProviderMeta).MustGetClient()
+
+		resp, err := client.Logical().Read(configUIHeaderPath(name))
+		if err != nil {
+			return err
+		}
+
+		if resp != nil {
+			return fmt.Errorf("UI header %q still exists", name)
+		}
+
+		return nil
+	}
+}
+
+func testConfigUIHeader_basic(name, values string) string {
+	return fmt.Sprintf(`
+resource "vault_config_ui_header" "test" {
+  name   = "%s"
+  values = [%s]
+}
+`, name, values)
+}
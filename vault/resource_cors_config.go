@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+const corsConfigPath = "sys/config/cors"
+
+// corsStdAllowedHeaders are the headers that Vault always allows, they are
+// returned in addition to the configured headers.
+var corsStdAllowedHeaders = map[string]bool{
+	"Content-Type":                  true,
+	"X-Requested-With":              true,
+	"X-Vault-AWS-IAM-Server-ID":     true,
+	"X-Vault-MFA":                   true,
+	"X-Vault-No-Request-Forwarding": true,
+	"X-Vault-Wrap-Format":           true,
+	"X-Vault-Wrap-TTL":              true,
+	"X-Vault-Policy-Override":       true,
+	"Authorization":                 true,
+	"X-Vault-Token":                 true,
+}
+
+func corsConfigResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: corsConfigWrite,
+		UpdateContext: corsConfigWrite,
+		ReadContext:   provider.ReadContextWrapper(corsConfigRead),
+		DeleteContext: corsConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldEnabled: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether CORS is enabled for the Vault API.",
+			},
+			consts.FieldAllowedOrigins: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The origins that are allowed to make cross-origin requests, "*" allows all origins.`,
+			},
+			consts.FieldAllowedHeaders: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Additional headers that are allowed on cross-origin requests. " +
+					"Vault's standard headers are always allowed.",
+			},
+		},
+	}
+}
+
+func corsConfigWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	data := map[string]interface{}{
+		consts.FieldEnabled:        d.Get(consts.FieldEnabled),
+		consts.FieldAllowedOrigins: util.TerraformSetToStringArray(d.Get(consts.FieldAllowedOrigins)),
+		consts.FieldAllowedHeaders: util.TerraformSetToStringArray(d.Get(consts.FieldAllowedHeaders)),
+	}
+
+	log.Printf("[DEBUG] Writing CORS config to %q", corsConfigPath)
+	if _, err := client.Logical().WriteWithContext(ctx, corsConfigPath, data); err != nil {
+		return diag.Errorf("error writing CORS config to %q: %s", corsConfigPath, err)
+	}
+	log.Printf("[DEBUG] Wrote CORS config to %q", corsConfigPath)
+
+	d.SetId(corsConfigPath)
+
+	return corsConfigRead(ctx, d, meta)
+}
+
+func corsConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Reading CORS config from %q", corsConfigPath)
+	resp, err := client.Logical().ReadWithContext(ctx, corsConfigPath)
+	if err != nil {
+		return diag.Errorf("error reading CORS config from %q: %s", corsConfigPath, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] CORS config %q not found, removing from state", corsConfigPath)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(consts.FieldEnabled, resp.Data[consts.FieldEnabled]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(consts.FieldAllowedOrigins, resp.Data[consts.FieldAllowedOrigins]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the standard headers are only kept if they were configured explicitly,
+	// otherwise they would always cause a diff
+	configured := map[string]bool{}
+	for _, h := range util.TerraformSetToStringArray(d.Get(consts.FieldAllowedHeaders)) {
+		configured[h] = true
+	}
+
+	var headers []string
+	if v, ok := resp.Data[consts.FieldAllowedHeaders].([]interface{}); ok {
+		for _, h := range v {
+			header := h.(string)
+			if corsStdAllowedHeaders[header] && !configured[header] {
+				continue
+			}
+			headers = append(headers, header)
+		}
+	}
+
+	if err := d.Set(consts.FieldAllowedHeaders, headers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func corsConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Deleting CORS config from %q", corsConfigPath)
+	if _, err := client.Logical().DeleteWithContext(ctx, corsConfigPath); err != nil {
+		return diag.Errorf("error deleting CORS config from %q: %s", corsConfigPath, err)
+	}
+	log.Printf("[DEBUG] Deleted CORS config from %q", corsConfigPath)
+
+	return nil
+}
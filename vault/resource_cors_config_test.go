@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestCORSConfig(t *testing.T) {
+	resourceName := "vault_cors_config.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy:             testCORSConfigCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCORSConfig_basic(`"https://example.com"`, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldEnabled, "true"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_origins.*", "https://example.com"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_headers.#", "0"),
+				),
+			},
+			{
+				Config: testCORSConfig_basic(`"https://example.com", "https://example.org"`, `"X-Custom-Header"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldEnabled, "true"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_origins.*", "https://example.org"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_headers.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_headers.*", "X-Custom-Header"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCORSConfigCheckDestroy(_ *terraform.State) error {
+	client := testProvider.Meta().(*provider.ProviderMeta).MustGetClient()
+
+	resp, err := client.Logical().Read(corsConfigPath)
+	if err != nil {
+		return err
+	}
+
+	if resp != nil {
+		if enabled, _ := resp.Data[consts.FieldEnabled].(bool); enabled {
+			return fmt.Errorf("CORS config %q is still enabled", corsConfigPath)
+		}
+	}
+
+	return nil
+}
+
+func testCORSConfig_basic(origins, headers string) string {
+	return fmt.Sprintf(`
+resource "vault_cors_config" "test" {
+  allowed_origins = [%s]
+  allowed_headers = [%s]
+}
+`, origins, headers)
+}
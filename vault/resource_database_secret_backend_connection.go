@@ -232,10 +232,21 @@ func getDatabaseSchema(typ schema.ValueType) schemaMap {
 					},
 					"password": {
 						Type:        schema.TypeString,
-						Required:    true,
+						Optional:    true,
 						Description: "The password to be used in the connection URL",
 						Sensitive:   true,
 					},
+					consts.FieldPasswordWO: {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Write-only field for the password to be used in the connection URL",
+						WriteOnly:   true,
+					},
+					consts.FieldPasswordWOVersion: {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Version counter for the password write-only field",
+					},
 					"ca_cert": {
 						Type:        schema.TypeString,
 						Optional:    true,
@@ -309,6 +320,17 @@ func getDatabaseSchema(typ schema.ValueType) schemaMap {
 						Description: "The password to use when authenticating with Cassandra.",
 						Sensitive:   true,
 					},
+					consts.FieldPasswordWO: {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Write-only field for the password to use when authenticating with Cassandra.",
+						WriteOnly:   true,
+					},
+					consts.FieldPasswordWOVersion: {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Version counter for the password write-only field",
+					},
 					"tls": {
 						Type:        schema.TypeBool,
 						Optional:    true,
@@ -404,10 +426,21 @@ func getDatabaseSchema(typ schema.ValueType) schemaMap {
 					},
 					"password": {
 						Type:        schema.TypeString,
-						Required:    true,
+						Optional:    true,
 						Description: "Specifies the password corresponding to the given username.",
 						Sensitive:   true,
 					},
+					consts.FieldPasswordWO: {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Write-only field for the password corresponding to the given username.",
+						WriteOnly:   true,
+					},
+					consts.FieldPasswordWOVersion: {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Version counter for the password write-only field",
+					},
 					"tls": {
 						Type:        schema.TypeBool,
 						Optional:    true,
@@ -466,10 +499,21 @@ func getDatabaseSchema(typ schema.ValueType) schemaMap {
 					},
 					"password": {
 						Type:        schema.TypeString,
-						Required:    true,
+						Optional:    true,
 						Description: "Specifies the password corresponding to the given username.",
 						Sensitive:   true,
 					},
+					consts.FieldPasswordWO: {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Write-only field for the password corresponding to the given username.",
+						WriteOnly:   true,
+					},
+					consts.FieldPasswordWOVersion: {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Version counter for the password write-only field",
+					},
 					"tls": {
 						Type:        schema.TypeBool,
 						Optional:    true,
@@ -643,10 +687,21 @@ func getDatabaseSchema(typ schema.ValueType) schemaMap {
 					},
 					"password": {
 						Type:        schema.TypeString,
-						Required:    true,
+						Optional:    true,
 						Description: "Specifies the password corresponding to the given username.",
 						Sensitive:   true,
 					},
+					consts.FieldPasswordWO: {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Write-only field for the password corresponding to the given username.",
+						WriteOnly:   true,
+					},
+					consts.FieldPasswordWOVersion: {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Version counter for the password write-only field",
+					},
 					"tls": {
 						Type:        schema.TypeBool,
 						Optional:    true,
@@ -697,6 +752,17 @@ func getDatabaseSchema(typ schema.ValueType) schemaMap {
 							"If omitted the credentials chain provider is used instead.",
 						Sensitive: true,
 					},
+					consts.FieldPasswordWO: {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Write-only field for the AWS secret key id to use to talk to ElastiCache.",
+						WriteOnly:   true,
+					},
+					consts.FieldPasswordWOVersion: {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Version counter for the password write-only field",
+					},
 					"region": {
 						Type:     schema.TypeString,
 						Optional: true,
@@ -744,6 +810,17 @@ func databaseSecretBackendConnectionResource() *schema.Resource {
 			return strings.Trim(v.(string), "/")
 		},
 	}
+	s[consts.FieldRotateRootOnCreate] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Description: "Rotate the root credentials immediately after the connection is configured, " +
+			"so that the configured password is only known to Vault.",
+	}
+	s[consts.FieldRotationVersion] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Description: "Version counter used to trigger a rotation of the root credentials.",
+	}
 
 	return &schema.Resource{
 		CreateContext: databaseSecretBackendConnectionCreateOrUpdate,
@@ -1144,12 +1221,7 @@ func setCassandraDatabaseConnectionData(d *schema.ResourceData, prefix string, d
 		data["username"] = v.(string)
 	}
 
-	passwordKey := prefix + consts.FieldPassword
-	if v, ok := d.GetOk(passwordKey); ok {
-		if d.IsNewResource() || d.HasChange(passwordKey) {
-			data[consts.FieldPassword] = v.(string)
-		}
-	}
+	setDatabaseConnectionPassword(d, prefix, data)
 
 	if v, ok := d.GetOkExists(prefix + "tls"); ok {
 		data["tls"] = v.(bool)
@@ -1406,6 +1478,10 @@ func getRedisConnectionDetailsFromResponse(d *schema.ResourceData, prefix string
 		// keep the password we have in state/config if the API doesn't return one
 		result["password"] = v.(string)
 	}
+	// ensure password_wo_version is updated in state
+	if v, ok := d.GetOk(prefix + consts.FieldPasswordWOVersion); ok {
+		result[consts.FieldPasswordWOVersion] = v.(int)
+	}
 	if v, ok := data["tls"]; ok {
 		result["tls"] = v.(bool)
 	}
@@ -1442,6 +1518,10 @@ func getRedisElastiCacheConnectionDetailsFromResponse(d *schema.ResourceData, pr
 	} else if v, ok := d.GetOk(prefix + "password"); ok {
 		result["password"] = v.(string)
 	}
+	// ensure password_wo_version is updated in state
+	if v, ok := d.GetOk(prefix + consts.FieldPasswordWOVersion); ok {
+		result[consts.FieldPasswordWOVersion] = v.(int)
+	}
 	if v, ok := data["region"]; ok {
 		result["region"] = v.(string)
 	} else if v, ok := d.GetOk(prefix + "region"); ok {
@@ -1475,6 +1555,10 @@ func getElasticsearchConnectionDetailsFromResponse(d *schema.ResourceData, prefi
 		// keep the password we have in state/config if the API doesn't return one
 		result["password"] = v.(string)
 	}
+	// ensure password_wo_version is updated in state
+	if v, ok := d.GetOk(prefix + consts.FieldPasswordWOVersion); ok {
+		result[consts.FieldPasswordWOVersion] = v.(int)
+	}
 	if v, ok := data["ca_cert"]; ok {
 		result["ca_cert"] = v.(string)
 	}
@@ -1520,6 +1604,10 @@ func getCouchbaseConnectionDetailsFromResponse(d *schema.ResourceData, prefix st
 		// keep the password we have in state/config if the API doesn't return one
 		result["password"] = v.(string)
 	}
+	// ensure password_wo_version is updated in state
+	if v, ok := d.GetOk(prefix + consts.FieldPasswordWOVersion); ok {
+		result[consts.FieldPasswordWOVersion] = v.(int)
+	}
 	if v, ok := data["tls"]; ok {
 		result["tls"] = v.(bool)
 	}
@@ -1564,6 +1652,10 @@ func getInfluxDBConnectionDetailsFromResponse(d *schema.ResourceData, prefix str
 		// keep the password we have in state/config if the API doesn't return one
 		result["password"] = v.(string)
 	}
+	// ensure password_wo_version is updated in state
+	if v, ok := d.GetOk(prefix + consts.FieldPasswordWOVersion); ok {
+		result[consts.FieldPasswordWOVersion] = v.(int)
+	}
 	if v, ok := data["tls"]; ok {
 		result["tls"] = v.(bool)
 	}
@@ -1785,12 +1877,7 @@ func setRedisDatabaseConnectionData(d *schema.ResourceData, prefix string, data
 		data["username"] = v.(string)
 	}
 
-	passwordKey := prefix + consts.FieldPassword
-	if v, ok := d.GetOk(passwordKey); ok {
-		if d.IsNewResource() || d.HasChange(passwordKey) {
-			data[consts.FieldPassword] = v.(string)
-		}
-	}
+	setDatabaseConnectionPassword(d, prefix, data)
 
 	if v, ok := d.GetOk(prefix + "tls"); ok {
 		data["tls"] = v.(bool)
@@ -1812,9 +1899,7 @@ func setRedisElastiCacheDatabaseConnectionData(d *schema.ResourceData, prefix st
 		data["username"] = v.(string)
 	}
 
-	if v, ok := d.GetOk(prefix + "password"); ok {
-		data["password"] = v.(string)
-	}
+	setDatabaseConnectionPassword(d, prefix, data)
 
 	if v, ok := d.GetOk(prefix + "region"); ok {
 		data["region"] = v.(string)
@@ -1830,12 +1915,7 @@ func setElasticsearchDatabaseConnectionData(d *schema.ResourceData, prefix strin
 		data["username"] = v.(string)
 	}
 
-	passwordKey := prefix + consts.FieldPassword
-	if v, ok := d.GetOk(passwordKey); ok {
-		if d.IsNewResource() || d.HasChange(passwordKey) {
-			data[consts.FieldPassword] = v.(string)
-		}
-	}
+	setDatabaseConnectionPassword(d, prefix, data)
 
 	if v, ok := d.GetOk(prefix + "ca_cert"); ok {
 		data["ca_cert"] = v.(string)
@@ -1878,12 +1958,7 @@ func setCouchbaseDatabaseConnectionData(d *schema.ResourceData, prefix string, d
 		data["username"] = v
 	}
 
-	passwordKey := prefix + consts.FieldPassword
-	if v, ok := d.GetOk(passwordKey); ok {
-		if d.IsNewResource() || d.HasChange(passwordKey) {
-			data[consts.FieldPassword] = v.(string)
-		}
-	}
+	setDatabaseConnectionPassword(d, prefix, data)
 
 	if v, ok := d.GetOkExists(prefix + "tls"); ok {
 		data["tls"] = v.(bool)
@@ -1914,12 +1989,7 @@ func setInfluxDBDatabaseConnectionData(d *schema.ResourceData, prefix string, da
 		data["username"] = v.(string)
 	}
 
-	passwordKey := prefix + consts.FieldPassword
-	if v, ok := d.GetOk(passwordKey); ok {
-		if d.IsNewResource() || d.HasChange(passwordKey) {
-			data[consts.FieldPassword] = v.(string)
-		}
-	}
+	setDatabaseConnectionPassword(d, prefix, data)
 
 	if v, ok := d.GetOkExists(prefix + "tls"); ok {
 		data["tls"] = v.(bool)
@@ -1959,6 +2029,12 @@ func setDatabaseConnectionDataWithUserPass(d *schema.ResourceData, prefix string
 
 	data[consts.FieldUsername] = d.Get(prefix + consts.FieldUsername)
 
+	setDatabaseConnectionPassword(d, prefix, data)
+}
+
+// setDatabaseConnectionPassword sets the password from either the password
+// or the password_wo field of the engine at prefix.
+func setDatabaseConnectionPassword(d *schema.ResourceData, prefix string, data map[string]interface{}) {
 	// Vault does not return the password in the API. If the root credentials have been rotated, sending
 	// the old password in the update request would break the connection config. Thus we only send it,
 	// if it actually changed to still support updating it for non-rotated cases.
@@ -2053,9 +2129,31 @@ func databaseSecretBackendConnectionCreateOrUpdate(
 	d.SetId(path)
 	log.Printf("[DEBUG] Wrote database connection config %q", path)
 
+	rotate := d.HasChange(consts.FieldRotationVersion)
+	if d.IsNewResource() {
+		rotate = d.Get(consts.FieldRotateRootOnCreate).(bool)
+	}
+	if rotate {
+		if err := databaseSecretBackendConnectionRotateRoot(ctx, client,
+			d.Get("backend").(string), d.Get("name").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return databaseSecretBackendConnectionRead(ctx, d, meta)
 }
 
+func databaseSecretBackendConnectionRotateRoot(ctx context.Context, client *api.Client, backend, name string) error {
+	rotatePath := strings.Trim(backend, "/") + "/rotate-root/" + strings.Trim(name, "/")
+	log.Printf("[DEBUG] Rotating root credentials at %q", rotatePath)
+	if _, err := client.Logical().WriteWithContext(ctx, rotatePath, nil); err != nil {
+		return fmt.Errorf("error rotating root credentials for %q: %s", rotatePath, err)
+	}
+	log.Printf("[DEBUG] Rotated root credentials at %q", rotatePath)
+
+	return nil
+}
+
 func writeDatabaseSecretConfig(ctx context.Context, d *schema.ResourceData, client *api.Client, engine *dbEngine, idx int, unifiedSchema bool, path string, meta interface{}) error {
 	data, err := getDatabaseAPIDataForEngine(engine, idx, d, meta)
 	if err != nil {
@@ -2085,6 +2183,8 @@ func writeDatabaseSecretConfig(ctx context.Context, d *schema.ResourceData, clie
 		data["root_rotation_statements"] = v
 	}
 
+	data[consts.FieldPasswordPolicy] = d.Get(prefix + consts.FieldPasswordPolicy)
+
 	if m, ok := d.GetOkExists(prefix + "data"); ok {
 		for k, v := range m.(map[string]interface{}) {
 			// Vault does not return the password in the API. If the root credentials have been rotated, sending
@@ -2226,6 +2326,10 @@ func getDBCommonConfig(d *schema.ResourceData, resp *api.Secret, engine *dbEngin
 		"plugin_name":       resp.Data["plugin_name"],
 	}
 
+	if v, ok := resp.Data[consts.FieldPasswordPolicy]; ok {
+		result[consts.FieldPasswordPolicy] = v
+	}
+
 	//"root_rotation_statements": resp.Data["root_credentials_rotate_statements"],
 	rootRotationStmts := make([]string, 0)
 	if v, ok := resp.Data["root_credentials_rotate_statements"]; ok && v != nil {
@@ -2325,6 +2429,10 @@ func getConnectionDetailsCassandra(d *schema.ResourceData, prefix string, resp *
 			// keep the password we have in state/config if the API doesn't return one
 			result["password"] = v.(string)
 		}
+		// ensure password_wo_version is updated in state
+		if v, ok := d.GetOk(prefix + consts.FieldPasswordWOVersion); ok {
+			result[consts.FieldPasswordWOVersion] = v.(int)
+		}
 		if v, ok := data["tls"]; ok {
 			result["tls"] = v.(bool)
 		}
@@ -2470,6 +2578,10 @@ func databaseEngineNameAndIndexFromPrefix(prefix string) (string, string, error)
 }
 
 func validateDatabaseConnectionConfig(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateDatabaseEnginePasswords(d); err != nil {
+		return err
+	}
+
 	// Validate Oracle self_managed configuration
 	oracleConfig, ok := d.GetOk(dbEngineOracle.name)
 	if !ok {
@@ -2519,3 +2631,58 @@ func validateDatabaseConnectionConfig(ctx context.Context, d *schema.ResourceDif
 
 	return nil
 }
+
+// validateDatabaseEnginePasswords ensures that password and password_wo are
+// not both set on the engine blocks that support either, and that one of them
+// is set on the engines that require a password.
+func validateDatabaseEnginePasswords(d *schema.ResourceDiff) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return nil
+	}
+
+	engines := []struct {
+		engine          *dbEngine
+		requirePassword bool
+	}{
+		{engine: dbEngineCassandra},
+		{engine: dbEngineCouchbase, requirePassword: true},
+		{engine: dbEngineElasticSearch, requirePassword: true},
+		{engine: dbEngineInfluxDB, requirePassword: true},
+		{engine: dbEngineRedis, requirePassword: true},
+		{engine: dbEngineRedisElastiCache},
+	}
+
+	isSet := func(v cty.Value) bool {
+		// unknown values are set from other resources
+		return !v.IsNull() && (!v.IsKnown() || v.AsString() != "")
+	}
+
+	for _, e := range engines {
+		blocks := rawConfig.GetAttr(e.engine.name)
+		if blocks.IsNull() || !blocks.IsKnown() {
+			continue
+		}
+
+		for i := 0; i < blocks.LengthInt(); i++ {
+			block := blocks.Index(cty.NumberIntVal(int64(i)))
+			if block.IsNull() || !block.IsKnown() {
+				continue
+			}
+
+			hasPassword := isSet(block.GetAttr(consts.FieldPassword))
+			hasPasswordWO := isSet(block.GetAttr(consts.FieldPasswordWO))
+			if hasPassword && hasPasswordWO {
+				return fmt.Errorf("%s.%d: only one of %q or %q can be set",
+					e.engine.name, i, consts.FieldPassword, consts.FieldPasswordWO)
+			}
+
+			if e.requirePassword && !hasPassword && !hasPasswordWO {
+				return fmt.Errorf("%s.%d: one of %q or %q must be set",
+					e.engine.name, i, consts.FieldPassword, consts.FieldPasswordWO)
+			}
+		}
+	}
+
+	return nil
+}
@@ -1018,6 +1018,39 @@ func TestAccDatabaseSecretBackendConnection_postgresql(t *testing.T) {
 	})
 }
 
+func TestAccDatabaseSecretBackendConnection_postgresql_passwordPolicy(t *testing.T) {
+	MaybeSkipDBTests(t, dbEnginePostgres)
+
+	values := testutil.SkipTestEnvUnset(t, "POSTGRES_URL")
+	connURL := values[0]
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	pluginName := dbEnginePostgres.DefaultPluginName()
+	name := acctest.RandomWithPrefix("db")
+	policyName := acctest.RandomWithPrefix("tf-test-policy")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy:             testAccDatabaseSecretBackendConnectionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendConnectionConfig_postgresql_passwordPolicy(name, backend, connURL, policyName, true),
+				Check: testComposeCheckFuncCommonDatabaseSecretBackend(name, backend, pluginName,
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, consts.FieldPasswordPolicy, policyName),
+				),
+			},
+			testutil.GetImportTestStep(testDefaultDatabaseSecretBackendResource, false, nil,
+				"verify_connection", "postgresql.0.connection_url"),
+			{
+				Config: testAccDatabaseSecretBackendConnectionConfig_postgresql_passwordPolicy(name, backend, connURL, policyName, false),
+				Check: testComposeCheckFuncCommonDatabaseSecretBackend(name, backend, pluginName,
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, consts.FieldPasswordPolicy, ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDatabaseSecretBackendConnection_postgresql_tls(t *testing.T) {
 	resourceName := "vault_database_secret_backend_connection.test"
 	backend := acctest.RandomWithPrefix("tf-test-db")
@@ -1227,6 +1260,52 @@ func TestAccDatabaseSecretBackendConnection_postgresql_password_wo(t *testing.T)
 	})
 }
 
+// TestAccDatabaseSecretBackendConnection_postgresql_rotateRoot ensures the
+// root credentials are rotated on create and when rotation_version changes
+//
+// To run locally you will need to set the following env vars:
+//   - POSTGRES_URL_TEST
+//   - POSTGRES_URL_ROOTLESS
+//
+// See .github/workflows/build.yml for details.
+func TestAccDatabaseSecretBackendConnection_postgresql_rotateRoot(t *testing.T) {
+	MaybeSkipDBTests(t, dbEnginePostgres)
+
+	connURLTestRoot := testutil.SkipTestEnvUnset(t, "POSTGRES_URL_TEST")[0]
+	connURLTemplated := testutil.SkipTestEnvUnset(t, "POSTGRES_URL_ROOTLESS")[0]
+	username := acctest.RandomWithPrefix("user")
+	dbName := acctest.RandomWithPrefix("db")
+	testutil.CreateTestPGUser(t, connURLTestRoot, username, "testpassword", testRoleStaticCreate)
+	mount := acctest.RandomWithPrefix("tf-test-db")
+	pluginName := dbEnginePostgres.DefaultPluginName()
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy:             testAccDatabaseSecretBackendConnectionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendConnectionConfig_postgresql_rotateRoot(dbName, mount, connURLTemplated, username, "testpassword", 0),
+				Check: testComposeCheckFuncCommonDatabaseSecretBackend(dbName, mount, pluginName,
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, consts.FieldRotateRootOnCreate, "true"),
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, consts.FieldRotationVersion, "0"),
+				),
+			},
+			{
+				Config: testAccDatabaseSecretBackendConnectionConfig_postgresql_rotateRoot(dbName, mount, connURLTemplated, username, "testpassword", 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testDefaultDatabaseSecretBackendResource, plancheck.ResourceActionUpdate),
+					},
+				},
+				// the rotation only succeeds if the rotated password from the previous step is used
+				Check: testComposeCheckFuncCommonDatabaseSecretBackend(dbName, mount, pluginName,
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, consts.FieldRotationVersion, "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDatabaseSecretBackendConnection_elasticsearch(t *testing.T) {
 	MaybeSkipDBTests(t, dbEngineElasticSearch)
 
@@ -1306,6 +1385,96 @@ func TestAccDatabaseSecretBackendConnection_elasticsearch(t *testing.T) {
 	})
 }
 
+// TestAccDatabaseSecretBackendConnection_elasticsearch_password_wo ensures
+// write-only attribute `password_wo` works for engines that are not
+// configured with a connection URL
+func TestAccDatabaseSecretBackendConnection_elasticsearch_password_wo(t *testing.T) {
+	MaybeSkipDBTests(t, dbEngineElasticSearch)
+
+	values := testutil.SkipTestEnvUnset(t, "ELASTIC_URL")
+	connURL := values[0]
+
+	username := os.Getenv("ELASTIC_USERNAME")
+	password := os.Getenv("ELASTIC_PASSWORD")
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	pluginName := dbEngineElasticSearch.DefaultPluginName()
+	name := acctest.RandomWithPrefix("db")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy:             testAccDatabaseSecretBackendConnectionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendConnectionConfig_elasticsearchWriteOnly(name, backend, connURL, username, password, 1),
+				Check: testComposeCheckFuncCommonDatabaseSecretBackend(name, backend, pluginName,
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "verify_connection", "true"),
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "elasticsearch.0.username", username),
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "elasticsearch.0.password", ""),
+					resource.TestCheckNoResourceAttr(testDefaultDatabaseSecretBackendResource, "elasticsearch.0.password_wo"),
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "elasticsearch.0.password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccDatabaseSecretBackendConnectionConfig_elasticsearchWriteOnly(name, backend, connURL, username, password, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testDefaultDatabaseSecretBackendResource, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testComposeCheckFuncCommonDatabaseSecretBackend(name, backend, pluginName,
+					resource.TestCheckResourceAttr(testDefaultDatabaseSecretBackendResource, "elasticsearch.0.password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabaseSecretBackendConnection_passwordValidation(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	name := acctest.RandomWithPrefix("db")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendConnectionConfig_passwordValidation(name, backend, "elasticsearch", `
+    url         = "http://localhost:9200"
+    username    = "elastic"
+    password    = "password"
+    password_wo = "password"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`elasticsearch.0: only one of "password" or "password_wo" can be set`),
+			},
+			{
+				Config: testAccDatabaseSecretBackendConnectionConfig_passwordValidation(name, backend, "redis", `
+    host     = "localhost"
+    username = "default"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`redis.0: one of "password" or "password_wo" must be set`),
+			},
+		},
+	})
+}
+
+func testAccDatabaseSecretBackendConnectionConfig_passwordValidation(name, path, engine, block string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend = vault_mount.db.path
+  name    = "%s"
+
+  %s {%s
+  }
+}
+`, path, name, engine, block)
+}
+
 func TestAccDatabaseSecretBackendConnection_snowflake_userpass(t *testing.T) {
 	MaybeSkipDBTests(t, dbEngineSnowflake)
 
@@ -1953,6 +2122,28 @@ resource "vault_database_secret_backend_connection" "test" {
 `, path, name, host, username, password, usernameTemplate)
 }
 
+func testAccDatabaseSecretBackendConnectionConfig_elasticsearchWriteOnly(name, path, host, username, password string, version int) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend       = vault_mount.db.path
+  name          = "%s"
+  allowed_roles = ["dev", "prod"]
+
+  elasticsearch {
+    url                 = "%s"
+    username            = "%s"
+    password_wo         = "%s"
+    password_wo_version = %d
+  }
+}
+`, path, name, host, username, password, version)
+}
+
 func testAccDatabaseSecretBackendConnectionConfig_mongodbatlas(name, path, public_key, private_key, project_id, username_template string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "db" {
@@ -2308,6 +2499,41 @@ resource "vault_database_secret_backend_connection" "test" {
 `, path, name, parsedURL.String(), openConn, idleConn, maxConnLifetime, username, password, userTempl)
 }
 
+func testAccDatabaseSecretBackendConnectionConfig_postgresql_passwordPolicy(name, path, connURL, policyName string, withPolicy bool) string {
+	passwordPolicy := ""
+	if withPolicy {
+		passwordPolicy = "password_policy = vault_password_policy.test.name"
+	}
+
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_password_policy" "test" {
+  name   = "%s"
+  policy = <<EOT
+length = 20
+rule "charset" {
+  charset = "abcdefghijklmnopqrstuvwxyz0123456789"
+}
+EOT
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend       = vault_mount.db.path
+  name          = "%s"
+  allowed_roles = ["dev", "prod"]
+  %s
+
+  postgresql {
+    connection_url = "%s"
+  }
+}
+`, path, policyName, name, passwordPolicy, connURL)
+}
+
 func testAccDatabaseSecretBackendConnectionConfig_postgresql_reset_optional_values(name, path string, parsedURL *url.URL) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "db" {
@@ -2460,6 +2686,30 @@ resource "vault_database_secret_backend_connection" "test" {
 `, path, name, connUrl, username, password, version)
 }
 
+func testAccDatabaseSecretBackendConnectionConfig_postgresql_rotateRoot(name, path, connUrl, username, password string, version int) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend               = vault_mount.db.path
+  name                  = "%s"
+  allowed_roles         = ["*"]
+  rotate_root_on_create = true
+  rotation_version      = %d
+
+  postgresql {
+    connection_url      = "%s"
+    username            = "%s"
+    password_wo         = "%s"
+    password_wo_version = 1
+  }
+}
+`, path, name, version, connUrl, username, password)
+}
+
 func testAccDatabaseSecretBackendConnectionConfig_snowflake_userpass(name, path, url, username, password, userTempl string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "db" {
@@ -68,6 +68,14 @@ func (s *dbConfigStore) init() {
 }
 
 func databaseSecretsMountCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := mountForceNoCacheCustomizeDiff(ctx, d, meta); err != nil {
+		return err
+	}
+
+	if err := validateDatabaseEnginePasswords(d); err != nil {
+		return err
+	}
+
 	// compute the number of configured database engines
 	var engineCount int
 	for _, engine := range dbEngines {
@@ -214,6 +222,11 @@ func getCommonDatabaseSchema() schemaMap {
 			// TODO: revert to true
 			Sensitive: false,
 		},
+		consts.FieldPasswordPolicy: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name of the password policy to use when generating passwords for this connection.",
+		},
 	}
 
 	// add common automated root rotation parameters
@@ -259,6 +259,10 @@ func gcpAuthBackendWrite(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	log.Printf("[DEBUG] Enabled gcp auth backend %q", path)
 
+	if err := waitForPath(ctx, meta, client, "sys/auth/"+path); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(path)
 
 	return gcpAuthBackendUpdate(ctx, d, meta)
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
 
@@ -97,6 +98,12 @@ func gcpSecretRolesetResource() *schema.Resource {
 				Computed:    true,
 				Description: "Email of the service account created by Vault for this Roleset",
 			},
+			consts.FieldRotationVersion: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "Version counter used to trigger a rotation of the Roleset's service account. " +
+					"Incrementing this value regenerates the service account and revokes all of its keys.",
+			},
 		},
 
 		CustomizeDiff: customdiff.ComputedIf("service_account_email", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
@@ -107,7 +114,7 @@ func gcpSecretRolesetResource() *schema.Resource {
 			oldHcl := gcpSecretRenderBindingsFromData(oldBinding)
 			newHcl := gcpSecretRenderBindingsFromData(newBinding)
 
-			return d.HasChange("token_scopes") || oldHcl != newHcl
+			return d.HasChange("token_scopes") || oldHcl != newHcl || d.HasChange(consts.FieldRotationVersion)
 		}),
 	}
 }
@@ -221,6 +228,15 @@ func gcpSecretRolesetUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	log.Printf("[DEBUG] Updated GCP Secrets backend roleset %q", path)
 
+	if d.HasChange(consts.FieldRotationVersion) {
+		rotatePath := path + "/rotate"
+		log.Printf("[DEBUG] Rotating GCP Secrets backend roleset %q", path)
+		if _, err := client.Logical().Write(rotatePath, nil); err != nil {
+			return fmt.Errorf("error rotating GCP Secrets backend roleset %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Rotated GCP Secrets backend roleset %q", path)
+	}
+
 	return gcpSecretRolesetRead(d, meta)
 }
 
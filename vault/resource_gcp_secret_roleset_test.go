@@ -5,7 +5,9 @@ package vault
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -80,6 +82,15 @@ func TestGCPSecretRoleset(t *testing.T) {
 					testGCPSecretRolesetAttrs(resourceName, backend, roleset),
 				),
 			},
+			{
+				Config: testGCPSecretRolesetConfig_rotation(backend, roleset, credentials, project, updatedRole, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "service_account_email"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRotationVersion, "1"),
+					resource.TestCheckResourceAttr(resourceName, "binding.0.roles.0", updatedRole),
+					testGCPSecretRolesetAttrs(resourceName, backend, roleset),
+				),
+			},
 			{
 				Config: testGCPSecretRolesetServiceAccountKey(backend, roleset, credentials, project, updatedRole),
 				Check: resource.ComposeTestCheckFunc(
@@ -100,6 +111,94 @@ func TestGCPSecretRoleset(t *testing.T) {
 	})
 }
 
+func TestGCPSecretRolesetRotationVersion(t *testing.T) {
+	config := func(rotationVersion int) map[string]interface{} {
+		return map[string]interface{}{
+			"backend":     "gcp",
+			"roleset":     "test",
+			"secret_type": "access_token",
+			"project":     "test",
+			"token_scopes": []interface{}{
+				"https://www.googleapis.com/auth/cloud-platform",
+			},
+			"binding": []interface{}{
+				map[string]interface{}{
+					"resource": "//cloudresourcemanager.googleapis.com/projects/test",
+					"roles":    []interface{}{"roles/viewer"},
+				},
+			},
+			consts.FieldRotationVersion: rotationVersion,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		create int
+		update int
+		want   bool
+	}{
+		{
+			name:   "incremented",
+			create: 1,
+			update: 2,
+			want:   true,
+		},
+		{
+			name:   "unchanged",
+			create: 1,
+			update: 1,
+			want:   false,
+		},
+		{
+			name:   "set-after-create",
+			create: 0,
+			update: 1,
+			want:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rotations int
+			meta := testMockVaultMeta(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/v1/gcp/roleset/test":
+					w.Header().Set("Content-Type", "application/json")
+					json.NewEncoder(w).Encode(map[string]interface{}{
+						"data": map[string]interface{}{
+							"secret_type":           "access_token",
+							"project":               "test",
+							"token_scopes":          []string{"https://www.googleapis.com/auth/cloud-platform"},
+							"service_account_email": "test@example.iam.gserviceaccount.com",
+							"bindings": map[string]interface{}{
+								"//cloudresourcemanager.googleapis.com/projects/test": []string{"roles/viewer"},
+							},
+						},
+					})
+					return
+				case r.URL.Path == "/v1/gcp/roleset/test/rotate":
+					rotations++
+				}
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			r := gcpSecretRolesetResource()
+			state := testResourceApply(t, r, nil, config(tt.create), meta)
+			if rotations != 0 {
+				t.Fatalf("expected no rotation on create, got %d", rotations)
+			}
+
+			// update the token scopes as well, so that an update is always applied
+			cfg := config(tt.update)
+			cfg["token_scopes"] = []interface{}{"https://www.googleapis.com/auth/cloud-platform.read-only"}
+			testResourceApply(t, r, state, cfg, meta)
+			if got := rotations > 0; got != tt.want {
+				t.Fatalf("rotated on update = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func testGCPSecretRolesetAttrs(resourceName, backend, roleset string, ignoreFields ...string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
@@ -222,6 +321,34 @@ resource "vault_gcp_secret_roleset" "test" {
 	return config
 }
 
+func testGCPSecretRolesetConfig_rotation(backend, roleSet, credentials, project, role string, rotationVersion int) string {
+	projectURI := fmt.Sprintf("//cloudresourcemanager.googleapis.com/projects/%s", project)
+	config := fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path = "%s"
+  credentials = <<CREDS
+%s
+CREDS
+}
+
+resource "vault_gcp_secret_roleset" "test" {
+  backend = vault_gcp_secret_backend.test.path
+  roleset = "%s"
+  secret_type = "access_token"
+  project = "%s"
+  token_scopes = ["https://www.googleapis.com/auth/cloud-platform"]
+  rotation_version = %d
+
+  binding {
+    resource = "%s"
+    roles = ["%s"]
+  }
+}
+`, backend, credentials, roleSet, project, rotationVersion, projectURI, role)
+
+	return config
+}
+
 func testGCPSecretRolesetServiceAccountKey(backend, roleset, credentials, project, role string) string {
 	projectURI := fmt.Sprintf("//cloudresourcemanager.googleapis.com/projects/%s", project)
 	config := fmt.Sprintf(`
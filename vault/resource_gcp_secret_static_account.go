@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
 
@@ -95,6 +96,12 @@ func gcpSecretStaticAccountResource() *schema.Resource {
 				Computed:    true,
 				Description: "Project of the GCP Service Account managed by this static account",
 			},
+			consts.FieldRotationVersion: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "Version counter used to trigger a rotation of the static account's service account key. " +
+					"Only supported for `access_token` static accounts.",
+			},
 		},
 	}
 }
@@ -204,6 +211,15 @@ func gcpSecretStaticAccountUpdate(d *schema.ResourceData, meta interface{}) erro
 	}
 	log.Printf("[DEBUG] Updated GCP Secrets backend static account %q", path)
 
+	if d.HasChange(consts.FieldRotationVersion) {
+		rotatePath := path + "/rotate-key"
+		log.Printf("[DEBUG] Rotating key for GCP Secrets backend static account %q", path)
+		if _, err := client.Logical().Write(rotatePath, nil); err != nil {
+			return fmt.Errorf("error rotating key for GCP Secrets backend static account %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Rotated key for GCP Secrets backend static account %q", path)
+	}
+
 	return gcpSecretStaticAccountRead(d, meta)
 }
 
@@ -5,7 +5,9 @@ package vault
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -128,6 +130,83 @@ func TestGCPSecretStaticAccount(t *testing.T) {
 	})
 }
 
+func TestGCPSecretStaticAccountRotationVersion(t *testing.T) {
+	config := func(rotationVersion int) map[string]interface{} {
+		return map[string]interface{}{
+			"backend":                   "gcp",
+			"static_account":            "test",
+			"secret_type":               "access_token",
+			"token_scopes":              []interface{}{"https://www.googleapis.com/auth/cloud-platform"},
+			"service_account_email":     "test@example.iam.gserviceaccount.com",
+			consts.FieldRotationVersion: rotationVersion,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		create int
+		update int
+		want   bool
+	}{
+		{
+			name:   "incremented",
+			create: 1,
+			update: 2,
+			want:   true,
+		},
+		{
+			name:   "unchanged",
+			create: 1,
+			update: 1,
+			want:   false,
+		},
+		{
+			name:   "set-after-create",
+			create: 0,
+			update: 1,
+			want:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rotations int
+			meta := testMockVaultMeta(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/v1/gcp/static-account/test":
+					w.Header().Set("Content-Type", "application/json")
+					json.NewEncoder(w).Encode(map[string]interface{}{
+						"data": map[string]interface{}{
+							"secret_type":             "access_token",
+							"token_scopes":            []string{"https://www.googleapis.com/auth/cloud-platform"},
+							"service_account_email":   "test@example.iam.gserviceaccount.com",
+							"service_account_project": "test",
+						},
+					})
+					return
+				case r.URL.Path == "/v1/gcp/static-account/test/rotate-key":
+					rotations++
+				}
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			r := gcpSecretStaticAccountResource()
+			state := testResourceApply(t, r, nil, config(tt.create), meta)
+			if rotations != 0 {
+				t.Fatalf("expected no rotation on create, got %d", rotations)
+			}
+
+			// update the token scopes as well, so that an update is always applied
+			cfg := config(tt.update)
+			cfg["token_scopes"] = []interface{}{"https://www.googleapis.com/auth/cloud-platform.read-only"}
+			testResourceApply(t, r, state, cfg, meta)
+			if got := rotations > 0; got != tt.want {
+				t.Fatalf("rotated on update = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func testGCPSecretStaticAccountAttrs(resourceName, backend, staticAccount string, ignoreFields ...string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
@@ -98,6 +98,10 @@ func githubAuthBackendCreate(ctx context.Context, d *schema.ResourceData, meta i
 	}
 	log.Printf("[INFO] Enabled github auth backend at '%s'", path)
 
+	if err := waitForPath(ctx, meta, client, "sys/auth/"+path); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(path)
 	d.MarkNewResource()
 	d.Partial(true)
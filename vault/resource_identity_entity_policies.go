@@ -21,6 +21,9 @@ func identityEntityPoliciesResource() *schema.Resource {
 		Update: identityEntityPoliciesUpdate,
 		Read:   provider.ReadWrapper(identityEntityPoliciesRead),
 		Delete: identityEntityPoliciesDelete,
+		Importer: &schema.ResourceImporter{
+			State: identityEntityPoliciesImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"policies": {
@@ -42,6 +45,7 @@ func identityEntityPoliciesResource() *schema.Resource {
 			"entity_id": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "ID of the entity.",
 			},
 
@@ -145,6 +149,20 @@ func identityEntityPoliciesRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// identityEntityPoliciesImport imports the policies of an entity in exclusive
+// mode, since the resource cannot know which of the policies are owned by
+// other non-exclusive resources.
+func identityEntityPoliciesImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("entity_id", d.Id()); err != nil {
+		return nil, err
+	}
+	if err := d.Set("exclusive", true); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func identityEntityPoliciesDelete(d *schema.ResourceData, meta interface{}) error {
 	client, e := provider.GetClient(d, meta)
 	if e != nil {
@@ -40,6 +40,7 @@ func TestAccIdentityEntityPoliciesExclusive(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_identity_entity_policies.policies", "policies.1", "test"),
 				),
 			},
+			testutil.GetImportTestStep("vault_identity_entity_policies.policies", false, nil),
 		},
 	})
 }
@@ -76,6 +76,24 @@ resource "%s" "test" {
 				),
 			},
 			importTestStep,
+			{
+				Config: fmt.Sprintf(`
+resource "%s" "test" {
+  secret_key_wo         = "secret-key-wo"
+  secret_key_wo_version = 1
+  integration_key       = "int-key-2"
+  api_hostname          = "foo.baz"
+}
+`, mfa.ResourceNameDuo),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					append(checksCommon,
+						resource.TestCheckResourceAttr(resourceName, consts.FieldSecretKey, ""),
+						resource.TestCheckNoResourceAttr(resourceName, consts.FieldSecretKeyWO),
+						resource.TestCheckResourceAttr(resourceName, consts.FieldSecretKeyWOVersion, "1"),
+						resource.TestCheckResourceAttr(resourceName, consts.FieldAPIHostname, "foo.baz"),
+					)...,
+				),
+			},
 		},
 	})
 }
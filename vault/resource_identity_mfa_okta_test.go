@@ -80,6 +80,24 @@ resource "%s" "test" {
 				),
 			},
 			importTestStep,
+			{
+				Config: fmt.Sprintf(`
+resource "%s" "test" {
+  org_name             = "org2"
+  api_token_wo         = "token-wo"
+  api_token_wo_version = 1
+  base_url             = "foo.baz.com"
+}
+`, mfa.ResourceNameOKTA),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					append(checksCommon,
+						resource.TestCheckResourceAttr(resourceName, consts.FieldOrgName, "org2"),
+						resource.TestCheckResourceAttr(resourceName, consts.FieldAPIToken, ""),
+						resource.TestCheckNoResourceAttr(resourceName, consts.FieldAPITokenWO),
+						resource.TestCheckResourceAttr(resourceName, consts.FieldAPITokenWOVersion, "1"),
+					)...,
+				),
+			},
 		},
 	})
 }
@@ -245,6 +245,10 @@ func jwtAuthBackendWrite(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.Errorf("error writing to Vault: %s", err)
 	}
 
+	if err := waitForPath(ctx, meta, client, "sys/auth/"+path); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(path)
 
 	return jwtAuthBackendUpdate(ctx, d, meta)
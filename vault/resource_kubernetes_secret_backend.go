@@ -22,6 +22,7 @@ func kubernetesSecretBackendResource() *schema.Resource {
 		ReadContext:   provider.ReadContextWrapper(kubernetesSecretBackendRead),
 		UpdateContext: kubernetesSecretBackendCreateUpdate,
 		DeleteContext: kubernetesSecretBackendDelete,
+		CustomizeDiff: mountForceNoCacheCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
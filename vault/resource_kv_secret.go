@@ -20,7 +20,7 @@ func kvSecretResource(name string) *schema.Resource {
 		CreateContext: kvSecretWrite,
 		UpdateContext: kvSecretWrite,
 		DeleteContext: kvSecretDelete,
-		ReadContext:   provider.ReadContextWrapper(provider.ReadContextWrapper(kvSecretRead)),
+		ReadContext:   provider.ReadContextWrapper(kvSecretRead),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -50,6 +50,13 @@ func kvSecretResource(name string) *schema.Resource {
 				Description: "Map of strings read from Vault.",
 				Sensitive:   true,
 			},
+			consts.FieldDisableRead: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If set to true, disables reading secret from Vault; " +
+					"note: drift won't be detected.",
+			},
 		},
 	}
 }
@@ -84,30 +91,44 @@ func kvSecretRead(_ context.Context, d *schema.ResourceData, meta interface{}) d
 		return diag.FromErr(err)
 	}
 
-	client, e := provider.GetClient(d, meta)
-	if e != nil {
-		return diag.FromErr(e)
-	}
-
-	log.Printf("[DEBUG] Reading %s from Vault", path)
-	secret, err := client.Logical().Read(path)
-	if err != nil {
-		return diag.Errorf("error reading from Vault: %s", err)
-	}
-	if secret == nil {
-		log.Printf("[WARN] secret (%s) not found, removing from state", path)
-		d.SetId("")
-		return nil
-	}
-
-	data := secret.Data
-	jsonData, err := json.Marshal(data)
-	if err != nil {
-		return diag.Errorf("error marshaling JSON for %q: %s", path, err)
+	disableRead := d.Get(consts.FieldDisableRead).(bool)
+	if err := d.Set(consts.FieldDisableRead, disableRead); err != nil {
+		return diag.FromErr(err)
 	}
 
-	if err := d.Set(consts.FieldDataJSON, string(jsonData)); err != nil {
-		return diag.FromErr(err)
+	var data map[string]interface{}
+	if disableRead {
+		// Populate data from data_json in the state
+		if err := json.Unmarshal([]byte(d.Get(consts.FieldDataJSON).(string)), &data); err != nil {
+			return diag.Errorf("data_json syntax error: %s", err)
+		}
+		log.Printf("[WARN] vault_kv_secret does not refresh when %s is set to true", consts.FieldDisableRead)
+	} else {
+		client, e := provider.GetClient(d, meta)
+		if e != nil {
+			return diag.FromErr(e)
+		}
+
+		log.Printf("[DEBUG] Reading %s from Vault", path)
+		secret, err := client.Logical().Read(path)
+		if err != nil {
+			return diag.Errorf("error reading from Vault: %s", err)
+		}
+		if secret == nil {
+			log.Printf("[WARN] secret (%s) not found, removing from state", path)
+			d.SetId("")
+			return nil
+		}
+
+		data = secret.Data
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return diag.Errorf("error marshaling JSON for %q: %s", path, err)
+		}
+
+		if err := d.Set(consts.FieldDataJSON, string(jsonData)); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	if err := d.Set(consts.FieldData, serializeDataMapToString(data)); err != nil {
@@ -64,6 +64,45 @@ func TestAccKVSecret(t *testing.T) {
 		},
 	})
 }
+func TestAccKVSecret_DisableRead(t *testing.T) {
+	t.Parallel()
+	resourceName := "vault_kv_secret.test"
+	mount := acctest.RandomWithPrefix("tf-kvv1")
+	name := acctest.RandomWithPrefix("tf-secret")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKVSecretConfig_disableRead(mount, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldPath, fmt.Sprintf("%s/%s", mount, name)),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDisableRead, "true"),
+					resource.TestCheckResourceAttr(resourceName, "data.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "data.zip", "zap"),
+					resource.TestCheckResourceAttr(resourceName, "data.foo", "bar"),
+					assertKVV1Data(resourceName),
+				),
+			},
+			{
+				PreConfig: func() {
+					client := testProvider.Meta().(*provider.ProviderMeta).MustGetClient()
+
+					// changes made outside of Terraform are not detected when disable_read is true
+					path := fmt.Sprintf("%s/%s", mount, name)
+					_, err := client.Logical().Write(path, map[string]interface{}{"testkey3": "testvalue3"})
+					if err != nil {
+						t.Fatalf("error simulating external change; err=%s", err)
+					}
+				},
+				Config:   testKVSecretConfig_disableRead(mount, name),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccKVSecret_UpdateOutsideTerraform(t *testing.T) {
 	t.Parallel()
 	resourceName := "vault_kv_secret.test"
@@ -185,3 +224,19 @@ func assertKVV1Data(resourceName string) resource.TestCheckFunc {
 		return testutil.AssertVaultState(client, s, path, tAttrs...)
 	}
 }
+
+func testKVSecretConfig_disableRead(mount, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_kv_secret" "test" {
+  path         = "${vault_mount.kvv1.path}/%s"
+  disable_read = true
+  data_json = jsonencode(
+    {
+      zip = "zap",
+      foo = "bar"
+    }
+  )
+}`, kvV1MountConfig(mount), name)
+}
@@ -4,16 +4,26 @@
 package vault
 
 import (
+	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/go-cty/cty"
 	"log"
 	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
@@ -21,6 +31,12 @@ import (
 	"github.com/hashicorp/terraform-provider-vault/util"
 )
 
+const (
+	kvV2DeleteBehaviorSoftDelete        = "soft_delete"
+	kvV2DeleteBehaviorDestroy           = "destroy"
+	kvV2DeleteBehaviorDeleteAllVersions = "delete_all_versions"
+)
+
 var (
 	kvV2SecretMountFromPathRegex = regexp.MustCompile("^(.+?)/data/.+$")
 	kvV2SecretNameFromPathRegex  = regexp.MustCompile("^.+?/data/(.+?)$")
@@ -43,9 +59,75 @@ func kvSecretV2DisableReadDiff(ctx context.Context, diff *schema.ResourceDiff, m
 		diff.Clear(consts.FieldData)
 		diff.Clear(consts.FieldMetadata)
 	}
+
+	drifted, err := kvSecretV2DataDrifted(diff)
+	if err != nil {
+		return err
+	}
+
+	if drifted {
+		log.Printf("[DEBUG] secret data at %q was changed outside of Terraform", diff.Id())
+		if err := diff.SetNewComputed(consts.FieldDataHash); err != nil {
+			return err
+		}
+	}
+
+	// Any change to the secret's data results in a new version being written,
+	// see kvSecretV2Write.
+	if diff.Id() != "" && (drifted || diff.HasChanges(consts.FieldDataJSON, consts.FieldDataJSONWOVersion, consts.FieldDataBase64)) {
+		if err := diff.SetNewComputed(consts.FieldVersion); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// kvSecretV2DataDrifted returns true if detect_drift is enabled and the hash of
+// the data read from Vault does not match the hash of the configured data.
+func kvSecretV2DataDrifted(diff *schema.ResourceDiff) (bool, error) {
+	if diff.Id() == "" || !diff.Get(consts.FieldDetectDrift).(bool) {
+		return false, nil
+	}
+
+	hash := diff.Get(consts.FieldDataHash).(string)
+	if hash == "" {
+		return false, nil
+	}
+
+	var buf []byte
+	if v := diff.GetRawConfig().GetAttr(consts.FieldDataJSON); v.IsKnown() && !v.IsNull() {
+		buf = []byte(v.AsString())
+	} else if v := diff.GetRawConfig().GetAttr(consts.FieldDataJSONWO); v.IsKnown() && !v.IsNull() {
+		buf = []byte(v.AsString())
+	}
+
+	dataBase64 := diff.Get(consts.FieldDataBase64).(map[string]interface{})
+	if buf == nil {
+		if len(dataBase64) == 0 {
+			return false, nil
+		}
+		buf = []byte("{}")
+	}
+
+	salt, _, err := parseKVV2DataHash(hash)
+	if err != nil {
+		return false, err
+	}
+
+	data, err := kvV2SecretData(buf, dataBase64)
+	if err != nil {
+		return false, err
+	}
+
+	expected, err := kvV2DataHash(data, salt)
+	if err != nil {
+		return false, err
+	}
+
+	return expected != hash, nil
+}
+
 func kvSecretV2Resource(name string) *schema.Resource {
 	return &schema.Resource{
 		CreateContext: kvSecretV2Write,
@@ -53,7 +135,7 @@ func kvSecretV2Resource(name string) *schema.Resource {
 		DeleteContext: kvSecretV2Delete,
 		ReadContext:   provider.ReadContextWrapper(kvSecretV2Read),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: kvSecretV2Import,
 		},
 		CustomizeDiff: kvSecretV2DisableReadDiff,
 
@@ -98,6 +180,22 @@ func kvSecretV2Resource(name string) *schema.Resource {
 				Default:  false,
 				Description: "If set to true, disables reading secret from Vault; " +
 					"note: drift won't be detected.",
+				ConflictsWith: []string{consts.FieldDetectDrift},
+			},
+
+			consts.FieldDetectDrift: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If set to true, changes to the secret data made outside of Terraform " +
+					"are detected by comparing a salted hash of the data.",
+				ConflictsWith: []string{consts.FieldDisableRead},
+			},
+
+			consts.FieldDataHash: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Salted hash of the secret data read from Vault, set when detect_drift is true.",
 			},
 
 			// Data is passed as JSON so that an arbitrary structure is
@@ -115,6 +213,15 @@ func kvSecretV2Resource(name string) *schema.Resource {
 				Sensitive:     true,
 				ConflictsWith: []string{consts.FieldDataJSONWO},
 			},
+			consts.FieldDataBase64: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: "Map of base64-encoded values to write in addition to the JSON-encoded " +
+					"secret data. The values are stored base64-encoded so that binary data is not corrupted.",
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validateKVV2DataBase64,
+				Sensitive:        true,
+			},
 			consts.FieldDataJSONWO: {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -143,11 +250,33 @@ func kvSecretV2Resource(name string) *schema.Resource {
 				Description: "Metadata associated with this secret read from Vault.",
 			},
 
+			consts.FieldVersion: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the secret that was last written or read from Vault.",
+			},
+
 			"delete_all_versions": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "If set to true, permanently deletes all versions for the specified key.",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				Description:   "If set to true, permanently deletes all versions for the specified key.",
+				ConflictsWith: []string{consts.FieldDeleteBehavior},
+			},
+
+			consts.FieldDeleteBehavior: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The behavior when the resource is destroyed. One of " +
+					"'soft_delete' to delete the latest version, 'destroy' to permanently destroy " +
+					"all versions while keeping the metadata, or 'delete_all_versions' to " +
+					"permanently delete all versions and the metadata.",
+				ValidateFunc: validation.StringInSlice([]string{
+					kvV2DeleteBehaviorSoftDelete,
+					kvV2DeleteBehaviorDestroy,
+					kvV2DeleteBehaviorDeleteAllVersions,
+				}, false),
+				ConflictsWith: []string{consts.FieldDeleteAllVersions},
 			},
 
 			consts.FieldCustomMetadata: {
@@ -188,6 +317,71 @@ func kvSecretV2Resource(name string) *schema.Resource {
 	}
 }
 
+// kvSecretV2Import imports a secret from its path, optionally suffixed with
+// "@<version>" to import the data of a specific version. The data_json is set
+// from the data read from Vault so that adopting an existing secret does not
+// write a new version when the configured data matches.
+func kvSecretV2Import(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	path := d.Id()
+	var version string
+	if i := strings.LastIndex(path, "@"); i >= 0 {
+		path, version = path[:i], path[i+1:]
+		if _, err := strconv.Atoi(version); err != nil {
+			return nil, fmt.Errorf("invalid version %q in import ID %q, err=%w", version, d.Id(), err)
+		}
+	}
+
+	if _, err := getKVV2SecretMountFromPath(path); err != nil {
+		return nil, fmt.Errorf("invalid import ID %q, expected <mount>/data/<name>[@<version>], err=%w", d.Id(), err)
+	}
+	if _, err := getKVV2SecretNameFromPath(path); err != nil {
+		return nil, fmt.Errorf("invalid import ID %q, expected <mount>/data/<name>[@<version>], err=%w", d.Id(), err)
+	}
+
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	var params map[string][]string
+	if version != "" {
+		params = map[string][]string{
+			consts.FieldVersion: {version},
+		}
+	}
+
+	secret, err := client.Logical().ReadWithData(path, params)
+	if err != nil {
+		return nil, fmt.Errorf("error reading secret %q from Vault: %w", path, err)
+	}
+	if secret == nil {
+		return nil, fmt.Errorf("no secret found at %q", path)
+	}
+
+	data, ok := secret.Data[consts.FieldData].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("secret %q has no data, the version may be deleted or destroyed", d.Id())
+	}
+
+	buf, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling JSON for %q: %w", path, err)
+	}
+
+	dataJSON, err := normalizeDataJSON(string(buf))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set(consts.FieldDataJSON, dataJSON); err != nil {
+		return nil, err
+	}
+
+	d.SetId(path)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func getKVV2Path(mount, name, prefix string) string {
 	return fmt.Sprintf("%s/%s/%s", mount, prefix, name)
 }
@@ -217,32 +411,58 @@ func kvSecretV2Write(ctx context.Context, d *schema.ResourceData, meta interface
 
 	path := getKVV2Path(mount, name, consts.FieldData)
 
+	// The data is only written on create, when it changes or when drift was
+	// detected, other updates, e.g. to the custom metadata, must not write a
+	// new secret version. This must match the changes for which
+	// kvSecretV2DisableReadDiff plans a new version.
 	var buf []byte
-	if v, ok := d.GetOk(consts.FieldDataJSON); ok {
-		buf = []byte(v.(string))
-	} else if d.IsNewResource() || d.HasChange(consts.FieldDataJSONWOVersion) {
-		p := cty.GetAttrPath(consts.FieldDataJSONWO)
-		woVal, _ := d.GetRawConfigAt(p)
-		buf = []byte(woVal.AsString())
+	if d.IsNewResource() || d.HasChanges(consts.FieldDataJSON, consts.FieldDataJSONWOVersion, consts.FieldDataHash, consts.FieldDataBase64) {
+		if v, ok := d.GetOk(consts.FieldDataJSON); ok {
+			buf = []byte(v.(string))
+		} else if woVal, _ := d.GetRawConfigAt(cty.GetAttrPath(consts.FieldDataJSONWO)); woVal.IsKnown() && !woVal.IsNull() {
+			buf = []byte(woVal.AsString())
+		} else if _, ok := d.GetOk(consts.FieldDataBase64); ok {
+			buf = []byte("{}")
+		}
 	}
 
-	var secretData map[string]interface{}
-	err := json.Unmarshal(buf, &secretData)
-	if err != nil {
-		return diag.Errorf("data_json %#v syntax error: %s", d.Get(consts.FieldDataJSON), err)
+	if buf == nil && d.IsNewResource() {
+		return diag.Errorf("one of %q, %q or %q must be set",
+			consts.FieldDataJSON, consts.FieldDataJSONWO, consts.FieldDataBase64)
 	}
 
-	data := map[string]interface{}{
-		"data": secretData,
-	}
+	if buf != nil {
+		secretData, err := kvV2SecretData(buf, d.Get(consts.FieldDataBase64).(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
-	kvFields := []string{"cas", "options"}
-	for _, k := range kvFields {
-		data[k] = d.Get(k)
-	}
+		data := map[string]interface{}{
+			"data": secretData,
+		}
 
-	if _, err := util.RetryWrite(client, path, data, util.DefaultRequestOpts()); err != nil {
-		return diag.FromErr(err)
+		kvFields := []string{"cas", "options"}
+		for _, k := range kvFields {
+			data[k] = d.Get(k)
+		}
+
+		resp, err := util.RetryWrite(client, path, data, util.DefaultRequestOpts())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// the version is also set on read, but must be set here for when disable_read is true.
+		if resp != nil {
+			if err := setKVV2SecretVersion(d, resp.Data); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if d.Get(consts.FieldDetectDrift).(bool) {
+			if err := setKVV2DataHash(d, secretData); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	d.SetId(path)
@@ -289,6 +509,11 @@ func kvSecretV2Read(_ context.Context, d *schema.ResourceData, meta interface{})
 		return diag.FromErr(err)
 	}
 
+	// set detect_drift to its default on import
+	if err := d.Set(consts.FieldDetectDrift, d.Get(consts.FieldDetectDrift)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	shouldRead := !d.Get(consts.FieldDisableRead).(bool)
 
 	if shouldRead {
@@ -314,12 +539,25 @@ func kvSecretV2Read(_ context.Context, d *schema.ResourceData, meta interface{})
 			return diag.FromErr(err)
 		}
 
+		if d.Get(consts.FieldDetectDrift).(bool) {
+			secretData, _ := secret.Data[consts.FieldData].(map[string]interface{})
+			if err := setKVV2DataHash(d, secretData); err != nil {
+				return diag.FromErr(err)
+			}
+		} else if err := d.Set(consts.FieldDataHash, ""); err != nil {
+			return diag.FromErr(err)
+		}
+
 		if metadata, ok := secret.Data["metadata"]; ok {
 			if v, ok := metadata.(map[string]interface{}); ok {
 				if err := d.Set(consts.FieldMetadata, serializeDataMapToString(v)); err != nil {
 					return diag.FromErr(err)
 				}
 
+				if err := setKVV2SecretVersion(d, v); err != nil {
+					return diag.FromErr(err)
+				}
+
 				// Read & Set custom metadata
 				if _, ok := v[consts.FieldCustomMetadata]; ok {
 					// construct metadata path
@@ -385,23 +623,201 @@ func kvSecretV2Delete(_ context.Context, d *schema.ResourceData, meta interface{
 	mount := d.Get(consts.FieldMount).(string)
 	name := d.Get(consts.FieldName).(string)
 
-	base := consts.FieldData
-	deleteAllVersions := d.Get("delete_all_versions").(bool)
-	if deleteAllVersions {
-		base = consts.FieldMetadata
+	behavior := kvV2DeleteBehaviorSoftDelete
+	if v, ok := d.GetOk(consts.FieldDeleteBehavior); ok {
+		behavior = v.(string)
+	} else if d.Get(consts.FieldDeleteAllVersions).(bool) {
+		behavior = kvV2DeleteBehaviorDeleteAllVersions
+	}
+
+	switch behavior {
+	case kvV2DeleteBehaviorDestroy:
+		if err := destroyKVV2SecretVersions(client, mount, name); err != nil {
+			return diag.FromErr(err)
+		}
+	default:
+		base := consts.FieldData
+		if behavior == kvV2DeleteBehaviorDeleteAllVersions {
+			base = consts.FieldMetadata
+		}
+
+		path := getKVV2Path(mount, name, base)
+
+		log.Printf("[DEBUG] Deleting vault_kv_secret_v2 from %q", path)
+		_, err := client.Logical().Delete(path)
+		if err != nil {
+			return diag.Errorf("error deleting %q from Vault: %q", path, err)
+		}
 	}
 
-	path := getKVV2Path(mount, name, base)
+	return nil
+}
 
-	log.Printf("[DEBUG] Deleting vault_kv_secret_v2 from %q", path)
-	_, err := client.Logical().Delete(path)
+// destroyKVV2SecretVersions permanently destroys all versions of the secret,
+// the secret's metadata is kept.
+func destroyKVV2SecretVersions(client *api.Client, mount, name string) error {
+	metadataPath := getKVV2Path(mount, name, consts.FieldMetadata)
+	resp, err := client.Logical().Read(metadataPath)
 	if err != nil {
-		return diag.Errorf("error deleting %q from Vault: %q", path, err)
+		return fmt.Errorf("error reading metadata from %q, err=%w", metadataPath, err)
+	}
+
+	if resp == nil {
+		log.Printf("[DEBUG] no metadata found at %q, nothing to destroy", metadataPath)
+		return nil
+	}
+
+	var versions []int
+	if v, ok := resp.Data["versions"].(map[string]interface{}); ok {
+		for k := range v {
+			version, err := strconv.Atoi(k)
+			if err != nil {
+				return fmt.Errorf("invalid version %q in metadata from %q, err=%w", k, metadataPath, err)
+			}
+			versions = append(versions, version)
+		}
+	}
+
+	if len(versions) == 0 {
+		return nil
+	}
+
+	sort.Ints(versions)
+
+	path := getKVV2Path(mount, name, "destroy")
+	log.Printf("[DEBUG] Destroying versions %v of vault_kv_secret_v2 at %q", versions, path)
+	if _, err := client.Logical().Write(path, map[string]interface{}{
+		"versions": versions,
+	}); err != nil {
+		return fmt.Errorf("error destroying versions at %q, err=%w", path, err)
 	}
 
 	return nil
 }
 
+// setKVV2DataHash sets the salted hash of the secret data, the salt of the
+// current hash is reused so that hashes can be compared.
+func setKVV2DataHash(d *schema.ResourceData, data map[string]interface{}) error {
+	var salt []byte
+	if v := d.Get(consts.FieldDataHash).(string); v != "" {
+		s, _, err := parseKVV2DataHash(v)
+		if err != nil {
+			return err
+		}
+		salt = s
+	} else {
+		salt = make([]byte, 16)
+		if _, err := rand.Read(salt); err != nil {
+			return fmt.Errorf("error generating salt, err=%w", err)
+		}
+	}
+
+	hash, err := kvV2DataHash(data, salt)
+	if err != nil {
+		return err
+	}
+
+	return d.Set(consts.FieldDataHash, hash)
+}
+
+// decodeKVV2Data decodes the JSON-encoded secret data, numbers are decoded the
+// same way as in the responses from Vault so that no precision is lost.
+func decodeKVV2Data(buf []byte) (map[string]interface{}, error) {
+	var data map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.UseNumber()
+	if err := dec.Decode(&data); err != nil {
+		return nil, fmt.Errorf("data_json syntax error: %w", err)
+	}
+
+	return data, nil
+}
+
+// kvV2SecretData returns the secret data to write from the JSON-encoded data
+// merged with the base64-encoded values, which are written as is.
+func kvV2SecretData(buf []byte, dataBase64 map[string]interface{}) (map[string]interface{}, error) {
+	data, err := decodeKVV2Data(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+
+	for k, v := range dataBase64 {
+		if _, ok := data[k]; ok {
+			return nil, fmt.Errorf("key %q is set in both the JSON-encoded data and %q", k, consts.FieldDataBase64)
+		}
+		data[k] = v
+	}
+
+	return data, nil
+}
+
+// validateKVV2DataBase64 ensures that all values of data_base64 are valid
+// base64-encoded strings.
+func validateKVV2DataBase64(i interface{}, path cty.Path) diag.Diagnostics {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return diag.Errorf("expected type of %q to be a map", consts.FieldDataBase64)
+	}
+
+	for k, v := range m {
+		s, _ := v.(string)
+		if _, err := base64.StdEncoding.DecodeString(s); err != nil {
+			return diag.Errorf("value of key %q in %q is not valid base64, err=%s", k, consts.FieldDataBase64, err)
+		}
+	}
+
+	return nil
+}
+
+// kvV2DataHash returns the salted hash of the secret data in the format
+// "<base64 salt>:<hex hmac-sha256>". The data is re-encoded so that the hash
+// does not depend on the formatting or key order of the JSON.
+func kvV2DataHash(data map[string]interface{}, salt []byte) (string, error) {
+	buf, err := json.Marshal(data)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling secret data, err=%w", err)
+	}
+
+	mac := hmac.New(sha256.New, salt)
+	mac.Write(buf)
+
+	return fmt.Sprintf("%s:%s", base64.StdEncoding.EncodeToString(salt), hex.EncodeToString(mac.Sum(nil))), nil
+}
+
+// parseKVV2DataHash returns the salt and hmac of a hash created by kvV2DataHash.
+func parseKVV2DataHash(hash string) ([]byte, string, error) {
+	parts := strings.SplitN(hash, ":", 2)
+	if len(parts) != 2 {
+		return nil, "", fmt.Errorf("invalid %q %q", consts.FieldDataHash, hash)
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid %q salt, err=%w", consts.FieldDataHash, err)
+	}
+
+	return salt, parts[1], nil
+}
+
+// setKVV2SecretVersion sets the version from the secret's metadata returned by Vault.
+func setKVV2SecretVersion(d *schema.ResourceData, metadata map[string]interface{}) error {
+	v, ok := metadata[consts.FieldVersion].(json.Number)
+	if !ok {
+		return nil
+	}
+
+	version, err := v.Int64()
+	if err != nil {
+		return fmt.Errorf("invalid %q %q returned from Vault, err=%w", consts.FieldVersion, v, err)
+	}
+
+	return d.Set(consts.FieldVersion, version)
+}
+
 func getKVV2SecretNameFromPath(path string) (string, error) {
 	if !kvV2SecretNameFromPathRegex.MatchString(path) {
 		return "", fmt.Errorf("no name found")
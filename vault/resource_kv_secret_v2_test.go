@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -97,6 +98,7 @@ func TestAccKVSecretV2(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "custom_metadata.0.max_versions", "0"),
 					resource.TestCheckResourceAttr(resourceName, "metadata.%", "5"),
 					resource.TestCheckResourceAttr(resourceName, "metadata.version", "1"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldVersion, "1"),
 					resource.TestCheckResourceAttr(resourceName, "metadata.destroyed", "false"),
 					resource.TestCheckResourceAttr(resourceName, "metadata.deletion_time", ""),
 					resource.TestCheckResourceAttr(resourceName, "metadata.custom_metadata", "null"),
@@ -118,6 +120,7 @@ func TestAccKVSecretV2(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "custom_metadata.0.max_versions", "5"),
 					resource.TestCheckResourceAttr(resourceName, "metadata.%", "5"),
 					resource.TestCheckResourceAttr(resourceName, "metadata.version", "2"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldVersion, "2"),
 					resource.TestCheckResourceAttr(resourceName, "metadata.destroyed", "false"),
 					resource.TestCheckResourceAttr(resourceName, "metadata.deletion_time", ""),
 					resource.TestCheckResourceAttr(resourceName, "metadata.custom_metadata", customMetadata),
@@ -128,10 +131,24 @@ func TestAccKVSecretV2(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"data_json", "disable_read",
+					"disable_read",
 					"delete_all_versions",
 				},
 			},
+			{
+				// Update custom metadata only, no new version must be written
+				Config: testKVSecretV2Config_updatedCustomMetadata(mount, name),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.0.max_versions", "10"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.version", "2"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldVersion, "2"),
+				),
+			},
 			{
 				Config: testKVSecretV2Config_initial(updatedMount, updatedName),
 				Check: resource.ComposeTestCheckFunc(
@@ -151,7 +168,7 @@ func TestAccKVSecretV2(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"data_json", "disable_read",
+					"disable_read",
 					"delete_all_versions",
 				},
 			},
@@ -209,6 +226,237 @@ func TestAccKVSecretV2_DisableRead(t *testing.T) {
 	})
 }
 
+func TestAccKVSecretV2_deleteBehaviorDestroy(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "vault_kv_secret_v2.test"
+	mount := acctest.RandomWithPrefix("tf-kv")
+	name := acctest.RandomWithPrefix("foo")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		Steps: []resource.TestStep{
+			{
+				Config: testKVSecretV2Config_deleteBehavior(mount, name, "destroy"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDeleteBehavior, "destroy"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldVersion, "1"),
+				),
+			},
+			{
+				// remove the secret, its versions must be destroyed and the metadata kept
+				Config: kvV2MountConfig(mount),
+				Check: func(s *terraform.State) error {
+					client := testProvider.Meta().(*provider.ProviderMeta).MustGetClient()
+
+					path := fmt.Sprintf("%s/metadata/%s", mount, name)
+					resp, err := client.Logical().Read(path)
+					if err != nil {
+						return fmt.Errorf("error reading from Vault; err=%s", err)
+					}
+					if resp == nil {
+						return fmt.Errorf("expected metadata to exist at %q", path)
+					}
+
+					versions, ok := resp.Data["versions"].(map[string]interface{})
+					if !ok || len(versions) != 1 {
+						return fmt.Errorf("expected 1 version in metadata, got %#v", resp.Data["versions"])
+					}
+
+					v, _ := versions["1"].(map[string]interface{})
+					if v["destroyed"] != true {
+						return fmt.Errorf("expected version 1 to be destroyed, got %#v", v)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccKVSecretV2_detectDrift(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "vault_kv_secret_v2.test"
+	mount := acctest.RandomWithPrefix("tf-kv")
+	name := acctest.RandomWithPrefix("foo")
+	expectedData := map[string]interface{}{
+		"zip": "zap",
+		"foo": "bar",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		Steps: []resource.TestStep{
+			{
+				Config: testKVSecretV2Config_detectDrift(mount, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDetectDrift, "true"),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldDataHash),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldVersion, "1"),
+					assertKVDataEquals(mount, name, expectedData),
+				),
+			},
+			{
+				// the secret is changed outside of Terraform, the drift must be detected
+				PreConfig: func() {
+					writeKVData(t, mount, name)
+				},
+				Config:             testKVSecretV2Config_detectDrift(mount, name),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testKVSecretV2Config_detectDrift(mount, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldDataHash),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldVersion, "3"),
+					assertKVDataEquals(mount, name, expectedData),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKVSecretV2_dataBase64(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "vault_kv_secret_v2.test"
+	mount := acctest.RandomWithPrefix("tf-kv")
+	name := acctest.RandomWithPrefix("foo")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKVSecretV2Config_dataBase64(mount, name, "not base64!"),
+				ExpectError: regexp.MustCompile(`is not valid base64`),
+			},
+			{
+				Config: testKVSecretV2Config_dataBase64(mount, name, "AAEC/w=="),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "data_base64.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_base64.blob", "AAEC/w=="),
+					assertKVDataEquals(mount, name, map[string]interface{}{
+						"foo":  "bar",
+						"blob": "AAEC/w==",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKVSecretV2_importVersion(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "vault_kv_secret_v2.test"
+	mount := acctest.RandomWithPrefix("tf-kv")
+	name := acctest.RandomWithPrefix("foo")
+	path := fmt.Sprintf("%s/data/%s", mount, name)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		Steps: []resource.TestStep{
+			{
+				Config: testKVSecretV2Config_initial(mount, name),
+			},
+			{
+				Config: testKVSecretV2Config_updated(mount, name),
+				Check:  resource.TestCheckResourceAttr(resourceName, consts.FieldVersion, "2"),
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: path + "@1",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 state, got %d", len(states))
+					}
+
+					s := states[0]
+					if s.ID != path {
+						return fmt.Errorf("expected ID %q, got %q", path, s.ID)
+					}
+
+					expected := `{"flag":false,"foo":"bar","zip":"zap"}`
+					if v := s.Attributes[consts.FieldDataJSON]; v != expected {
+						return fmt.Errorf("expected %q %q, got %q", consts.FieldDataJSON, expected, v)
+					}
+
+					return nil
+				},
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: path + "@foo",
+				ExpectError:   regexp.MustCompile(`invalid version "foo"`),
+			},
+		},
+	})
+}
+
+func TestKVV2DataHash(t *testing.T) {
+	salt := []byte("0123456789abcdef")
+
+	hash, err := kvV2DataHashFromJSON([]byte(`{"foo": "bar", "zip": {"zap": 1.10}}`), salt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// key order and whitespace must not change the hash
+	other, err := kvV2DataHashFromJSON([]byte(`{"zip":{"zap":1.10},"foo":"bar"}`), salt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash != other {
+		t.Fatalf("expected hash %q, got %q", hash, other)
+	}
+
+	gotSalt, _, err := parseKVV2DataHash(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(salt, gotSalt) {
+		t.Fatalf("expected salt %q, got %q", salt, gotSalt)
+	}
+
+	other, err = kvV2DataHashFromJSON([]byte(`{"foo": "bar", "zip": {"zap": 1.10}}`), []byte("fedcba9876543210"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash == other {
+		t.Fatalf("expected a different hash for a different salt")
+	}
+
+	other, err = kvV2DataHashFromJSON([]byte(`{"foo": "baz", "zip": {"zap": 1.10}}`), salt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash == other {
+		t.Fatalf("expected a different hash for different data")
+	}
+
+	for _, invalid := range []string{"", "foo", "!!!:abc"} {
+		if _, _, err := parseKVV2DataHash(invalid); err == nil {
+			t.Fatalf("expected an error parsing %q", invalid)
+		}
+	}
+}
+
+// kvV2DataHashFromJSON returns the salted hash of the JSON-encoded secret data.
+func kvV2DataHashFromJSON(buf []byte, salt []byte) (string, error) {
+	data, err := decodeKVV2Data(buf)
+	if err != nil {
+		return "", err
+	}
+
+	return kvV2DataHash(data, salt)
+}
+
 // TestAccKVSecretV2_data_json_wo ensures write-only attribute
 // `data_json_wo` works as expected
 func TestAccKVSecretV2_data_json_wo(t *testing.T) {
@@ -247,6 +495,19 @@ func TestAccKVSecretV2_data_json_wo(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, consts.FieldDataJSONWOVersion, "2"),
 				),
 			},
+			{
+				// Update custom metadata only, the write-only data must not be rewritten
+				Config: testKVSecretV2Config_data_json_wo_custom_metadata(mount, name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					assertKVDataEquals(mount, name, map[string]interface{}{
+						"zip": "zap",
+						"foo": "bar",
+					}),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDataJSONWOVersion, "2"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldVersion, "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.0.max_versions", "5"),
+				),
+			},
 			testutil.GetImportTestStep(resourceName, false, nil, consts.FieldDataJSONWO, consts.FieldDataJSONWOVersion,
 				consts.FieldDisableRead, consts.FieldDeleteAllVersions),
 		},
@@ -456,6 +717,36 @@ resource "vault_kv_secret_v2" "test" {
 	return ret
 }
 
+func testKVSecretV2Config_updatedCustomMetadata(mount, name string) string {
+	ret := fmt.Sprintf(`
+%s
+
+`, kvV2MountConfig(mount))
+
+	ret += fmt.Sprintf(`
+resource "vault_kv_secret_v2" "test" {
+  mount               = vault_mount.kvv2.path
+  name                = "%s"
+  delete_all_versions = true
+  data_json = jsonencode(
+    {
+      zip  = "zoop",
+      foo  = "baz",
+      flag = false
+    }
+  )
+  custom_metadata {
+    max_versions = 10
+    data = {
+      extra = "cheese",
+      pizza = "please"
+    }
+  }
+}`, name)
+
+	return ret
+}
+
 func testKVSecretV2Config_data_json_wo(mount, name string, version int) string {
 	ret := fmt.Sprintf(`
 %s
@@ -499,3 +790,80 @@ resource "vault_kv_secret_v2" "test" {
 
 	return ret
 }
+
+func testKVSecretV2Config_data_json_wo_custom_metadata(mount, name string, version int) string {
+	ret := fmt.Sprintf(`
+%s
+
+`, kvV2MountConfig(mount))
+
+	ret += fmt.Sprintf(`
+resource "vault_kv_secret_v2" "test" {
+  mount               = vault_mount.kvv2.path
+  name                = "%s"
+  data_json_wo = jsonencode(
+    {
+      zip  = "zap",
+      foo  = "bar",
+    }
+  )
+  data_json_wo_version = %d
+  custom_metadata {
+    max_versions = 5
+  }
+}`, name, version)
+
+	return ret
+}
+
+func testKVSecretV2Config_deleteBehavior(mount, name, behavior string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_kv_secret_v2" "test" {
+  mount           = vault_mount.kvv2.path
+  name            = "%s"
+  delete_behavior = "%s"
+  data_json = jsonencode(
+    {
+      zip = "zap",
+      foo = "bar"
+    }
+  )
+}`, kvV2MountConfig(mount), name, behavior)
+}
+
+func testKVSecretV2Config_detectDrift(mount, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_kv_secret_v2" "test" {
+  mount        = vault_mount.kvv2.path
+  name         = "%s"
+  detect_drift = true
+  data_json = jsonencode(
+    {
+      zip = "zap",
+      foo = "bar"
+    }
+  )
+}`, kvV2MountConfig(mount), name)
+}
+
+func testKVSecretV2Config_dataBase64(mount, name, blob string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_kv_secret_v2" "test" {
+  mount     = vault_mount.kvv2.path
+  name      = "%s"
+  data_json = jsonencode(
+    {
+      foo = "bar"
+    }
+  )
+  data_base64 = {
+    blob = "%s"
+  }
+}`, kvV2MountConfig(mount), name, blob)
+}
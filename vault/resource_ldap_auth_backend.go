@@ -294,6 +294,10 @@ func ldapAuthBackendWrite(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	log.Printf("[DEBUG] Enabled LDAP auth backend %q", path)
 
+	if err := waitForPath(ctx, meta, client, "sys/auth/"+path); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(path)
 
 	return ldapAuthBackendUpdate(ctx, d, meta)
@@ -22,6 +22,7 @@ const (
 	kmsTypePKCS  = "pkcs11"
 	kmsTypeAWS   = "awskms"
 	kmsTypeAzure = "azurekeyvault"
+	kmsTypeGCP   = "gcpckms"
 )
 
 type managedKeysConfig struct {
@@ -49,10 +50,17 @@ var (
 		schemaFunc:   managedKeysPKCSConfigSchema,
 	}
 
+	managedKeysGCPConfig = &managedKeysConfig{
+		providerType: consts.FieldGCP,
+		keyType:      kmsTypeGCP,
+		schemaFunc:   managedKeysGCPConfigSchema,
+	}
+
 	managedKeyProviders = []*managedKeysConfig{
 		managedKeysAWSConfig,
 		managedKeysAzureConfig,
 		managedKeysPKCSConfig,
+		managedKeysGCPConfig,
 	}
 )
 
@@ -104,6 +112,15 @@ func managedKeysResource() *schema.Resource {
 				},
 				Set: hashManagedKeys,
 			},
+			managedKeysGCPConfig.providerType: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Configuration block for GCP Cloud KMS Managed Keys",
+				Elem: &schema.Resource{
+					Schema: managedKeysGCPConfig.schemaFunc(),
+				},
+				Set: hashManagedKeys,
+			},
 		},
 	}
 }
@@ -365,6 +382,52 @@ func managedKeysAzureConfigSchema() schemaMap {
 	return setCommonManagedKeysSchema(s)
 }
 
+func managedKeysGCPConfigSchema() schemaMap {
+	s := schemaMap{
+		consts.FieldName: {
+			Type:     schema.TypeString,
+			Required: true,
+			Description: "A unique lowercase name that serves as " +
+				"identifying the key",
+		},
+		consts.FieldCredentials: {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+			Description: "The path to the GCP credentials file or the JSON-encoded " +
+				"credentials to query the Cloud KMS APIs",
+		},
+		consts.FieldProject: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The GCP project that contains the key ring",
+		},
+		consts.FieldRegion: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The GCP region of the key ring",
+		},
+		consts.FieldKeyRing: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The Cloud KMS key ring that contains the key",
+		},
+		consts.FieldCryptoKey: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The Cloud KMS crypto key to use",
+		},
+		consts.FieldAlgorithm: {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "The algorithm of the key, e.g. 'ec_sign_p256_sha256'. " +
+				"Required if 'allow_generate_key' is true",
+		},
+	}
+
+	return setCommonManagedKeysSchema(s)
+}
+
 func getManagedKeysConfigData(config map[string]interface{}, sm schemaMap) (string, map[string]interface{}) {
 	data := map[string]interface{}{}
 	var name string
@@ -511,6 +574,12 @@ func createUpdateManagedKeys(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
+	if _, ok := d.GetOk(consts.FieldGCP); ok {
+		if diags := writeManagedKeysData(d, client, consts.FieldGCP); diags != nil {
+			return diags
+		}
+	}
+
 	// set ID to 'default'
 	d.SetId("default")
 
@@ -640,6 +709,16 @@ func readPKCSManagedKeys(d *schema.ResourceData, client *api.Client) error {
 	return nil
 }
 
+func readGCPManagedKeys(d *schema.ResourceData, client *api.Client) error {
+	redacted := []string{consts.FieldCredentials}
+	if err := readAndSetManagedKeys(d, client, consts.FieldGCP,
+		map[string]string{consts.FieldUUID: "UUID"}, redacted); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func readManagedKeys(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, e := provider.GetClient(d, meta)
 	if e != nil {
@@ -669,6 +748,13 @@ func readManagedKeys(_ context.Context, d *schema.ResourceData, meta interface{}
 		})
 	}
 
+	if err := readGCPManagedKeys(d, client); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Failed to read GCP Managed Keys, err=%s", err),
+		})
+	}
+
 	return diags
 }
 
@@ -730,5 +816,11 @@ func deleteManagedKeys(_ context.Context, d *schema.ResourceData, meta interface
 		}
 	}
 
+	if _, ok := d.GetOk(consts.FieldGCP); ok {
+		if diags := deleteManagedKeyType(client, kmsTypeGCP); diags != nil {
+			return diags
+		}
+	}
+
 	return nil
 }
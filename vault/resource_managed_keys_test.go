@@ -206,6 +206,48 @@ func TestManagedKeysPKCS(t *testing.T) {
 	})
 }
 
+// The following test requires a GCP Cloud KMS key ring and crypto key, and needs the
+// following environment variables to operate successfully:
+// * GOOGLE_CREDENTIALS or GOOGLE_CREDENTIALS_FILE
+// * GOOGLE_PROJECT
+// * GCP_KMS_REGION
+// * GCP_KMS_KEY_RING
+// * GCP_KMS_CRYPTO_KEY
+func TestManagedKeysGCP(t *testing.T) {
+	values := testutil.SkipTestEnvUnset(t, "GCP_KMS_REGION", "GCP_KMS_KEY_RING", "GCP_KMS_CRYPTO_KEY")
+	region, keyRing, cryptoKey := values[0], values[1], values[2]
+
+	name := acctest.RandomWithPrefix("gcp-keys")
+	resourceName := "vault_managed_keys.test"
+
+	creds, project := testutil.GetTestGCPCreds(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestEntPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		Steps: []resource.TestStep{
+			{
+				Config: testManagedKeysConfig_gcp(name, creds, project, region, keyRing, cryptoKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "gcp.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "gcp.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "gcp.0.project", project),
+					resource.TestCheckResourceAttr(resourceName, "gcp.0.region", region),
+					resource.TestCheckResourceAttr(resourceName, "gcp.0.key_ring", keyRing),
+					resource.TestCheckResourceAttr(resourceName, "gcp.0.crypto_key", cryptoKey),
+					resource.TestCheckResourceAttrSet(resourceName, "gcp.0.uuid"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"gcp.0.credentials"},
+			},
+		},
+	})
+}
+
 func testManagedKeysConfig_basic(name0, name1 string) string {
 	return fmt.Sprintf(`
 resource "vault_managed_keys" "test" {
@@ -261,3 +303,18 @@ resource "vault_managed_keys" "test" {
 }
 `, name, library, slot, pin)
 }
+
+func testManagedKeysConfig_gcp(name, creds, project, region, keyRing, cryptoKey string) string {
+	return fmt.Sprintf(`
+resource "vault_managed_keys" "test" {
+  gcp {
+    name        = "%s"
+    credentials = %q
+    project     = "%s"
+    region      = "%s"
+    key_ring    = "%s"
+    crypto_key  = "%s"
+  }
+}
+`, name, creds, project, region, keyRing, cryptoKey)
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/terraform-provider-vault/util/mountutil"
 )
 
@@ -59,7 +60,7 @@ func getMountSchema(excludes ...string) schemaMap {
 			Description: "Maximum possible lease duration for tokens and secrets in seconds",
 		},
 
-		// this field is cannot be tuned
+		// this field cannot be tuned, changes are rejected by mountForceNoCacheCustomizeDiff
 		consts.FieldForceNoCache: {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -104,7 +105,7 @@ func getMountSchema(excludes ...string) schemaMap {
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
 			},
-			Description: "List of headers to allow and pass from the request to the plugin",
+			Description: "List of headers to allow, allowing a plugin to include them in the response",
 		},
 
 		consts.FieldPluginVersion: {
@@ -126,7 +127,7 @@ func getMountSchema(excludes ...string) schemaMap {
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
 			},
-			Description: "List of headers to allow and pass from the request to the plugin",
+			Description: "List of allowed authentication mount accessors the backend can request delegated authentication for",
 		},
 
 		consts.FieldIdentityTokenKey: {
@@ -188,6 +189,7 @@ func MountResource() *schema.Resource {
 		UpdateContext: mountUpdate,
 		DeleteContext: mountDelete,
 		ReadContext:   provider.ReadContextWrapper(mountRead),
+		CustomizeDiff: mountForceNoCacheCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -195,6 +197,18 @@ func MountResource() *schema.Resource {
 	}
 }
 
+// mountForceNoCacheCustomizeDiff rejects changes to force_no_cache on an
+// existing mount. Vault does not support tuning the field, and replacing the
+// mount would remove all data stored in it.
+func mountForceNoCacheCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || !diff.HasChange(consts.FieldForceNoCache) {
+		return nil
+	}
+
+	return fmt.Errorf("%q can only be set when the mount is created, "+
+		"remove the mount to change it", consts.FieldForceNoCache)
+}
+
 func mountWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := provider.GetClient(d, meta)
 	if err != nil {
@@ -270,6 +284,25 @@ func createMount(ctx context.Context, d *schema.ResourceData, meta interface{},
 		return fmt.Errorf("error writing to Vault: %s", err)
 	}
 
+	return waitForPath(ctx, meta, client, "sys/mounts/"+path)
+}
+
+// waitForPath blocks until path can be read from Vault. On Vault Enterprise,
+// newly created mounts and namespaces may not be visible on performance
+// standbys or secondaries right away, so resources that depend on them and are
+// created in the same apply could otherwise fail with a 404 or 412. The
+// provider's client has ReadYourWrites enabled, so the read is only served by
+// nodes that have reached the X-Vault-Index of the write that created the path.
+func waitForPath(ctx context.Context, meta interface{}, client *api.Client, path string) error {
+	if !provider.IsEnterpriseSupported(meta) {
+		return nil
+	}
+
+	opts := util.EventualConsistencyRequestOpts(uint64(provider.MaxHTTPRetriesCCC))
+	if _, err := util.RetryRead(ctx, client, path, opts); err != nil {
+		return fmt.Errorf("error waiting for %q to become available: %w", path, err)
+	}
+
 	return nil
 }
 
@@ -7,14 +7,17 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/vault/api"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 	"github.com/hashicorp/terraform-provider-vault/testutil"
 )
@@ -172,6 +175,95 @@ func TestResourceMount_AuditNonHMACRequestKeys(t *testing.T) {
 	})
 }
 
+// TestResourceMount_TuneInPlace checks that changing the tunable fields of a
+// mount updates it in place, so that the data stored in the mount is kept.
+func TestResourceMount_TuneInPlace(t *testing.T) {
+	resourceName := "vault_mount.test"
+	path := acctest.RandomWithPrefix("example")
+
+	var accessor string
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceMount_tuneConfig(path, 3600, "request1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_lease_ttl_seconds", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "audit_non_hmac_request_keys.0", "request1"),
+					func(s *terraform.State) error {
+						accessor = s.RootModule().Resources[resourceName].Primary.Attributes["accessor"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testResourceMount_tuneConfig(path, 7200, "request2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_lease_ttl_seconds", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "max_lease_ttl_seconds", "14400"),
+					resource.TestCheckResourceAttr(resourceName, "audit_non_hmac_request_keys.0", "request2"),
+					func(s *terraform.State) error {
+						if actual := s.RootModule().Resources[resourceName].Primary.Attributes["accessor"]; actual != accessor {
+							return fmt.Errorf("expected the mount to be tuned in place, accessor changed from %q to %q", accessor, actual)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// TestResourceMount_ForceNoCacheChange checks that changing force_no_cache on
+// an existing mount is rejected instead of replacing the mount.
+func TestResourceMount_ForceNoCacheChange(t *testing.T) {
+	resourceName := "vault_mount.test"
+	path := acctest.RandomWithPrefix("example")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceMount_forceNoCacheConfig(path, false),
+				Check:  resource.TestCheckResourceAttr(resourceName, consts.FieldForceNoCache, "false"),
+			},
+			{
+				Config:      testResourceMount_forceNoCacheConfig(path, true),
+				ExpectError: regexp.MustCompile(`"force_no_cache" can only be set when the mount is created`),
+			},
+		},
+	})
+}
+
+func testResourceMount_forceNoCacheConfig(path string, forceNoCache bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path           = "%s"
+  type           = "kv"
+  force_no_cache = %t
+}
+`, path, forceNoCache)
+}
+
+func testResourceMount_tuneConfig(path string, ttl int, requestKey string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path                        = "%s"
+  type                        = "kv"
+  default_lease_ttl_seconds   = %d
+  max_lease_ttl_seconds       = %d
+  audit_non_hmac_request_keys = ["%s"]
+}
+`, path, ttl, ttl*2, requestKey)
+}
+
 // TestResourceMount_AllowedResponseHeaders_Removal checks that after the allowed response headers were set on an
 // vault auth mount, that if the headers were removed from the configuration, then the vault field would be updated
 // accordingly.  This is a regression test. VAULT-34426
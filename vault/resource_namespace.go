@@ -88,6 +88,10 @@ func namespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface
 		return diag.Errorf("error writing to Vault: %s", err)
 	}
 
+	if err := waitForPath(ctx, meta, client, consts.SysNamespaceRoot+path); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return namespaceRead(ctx, d, meta)
 }
 
@@ -105,14 +109,22 @@ func namespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface
 
 	path := d.Get(consts.FieldPath).(string)
 
-	var data map[string]interface{}
-	if v, ok := d.GetOk(consts.FieldCustomMetadata); ok {
-		data = map[string]interface{}{
-			consts.FieldCustomMetadata: v,
-		}
+	// keys that were removed from the custom metadata are set to null, so
+	// that the merge patch deletes them
+	o, n := d.GetChange(consts.FieldCustomMetadata)
+	customMetadata := map[string]interface{}{}
+	for k := range o.(map[string]interface{}) {
+		customMetadata[k] = nil
+	}
+	for k, v := range n.(map[string]interface{}) {
+		customMetadata[k] = v
 	}
 
-	log.Printf("[DEBUG] Creating namespace %s in Vault", path)
+	data := map[string]interface{}{
+		consts.FieldCustomMetadata: customMetadata,
+	}
+
+	log.Printf("[DEBUG] Updating namespace %s in Vault", path)
 	if _, err := client.Logical().JSONMergePatch(ctx, consts.SysNamespaceRoot+path, data); err != nil {
 		return diag.Errorf("error writing to Vault: %s", err)
 	}
@@ -185,7 +197,7 @@ func namespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}
 	}
 
 	if resp == nil {
-		log.Printf("[WARN] Names %q not found, removing from state.", path)
+		log.Printf("[WARN] Namespace %q not found, removing from state.", path)
 		d.SetId("")
 		return nil
 	}
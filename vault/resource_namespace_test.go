@@ -106,6 +106,17 @@ func TestAccNamespace(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceNameParent, "custom_metadata.bar", "123"),
 					testNamespaceDestroy(namespacePath)),
 			},
+			{
+				SkipFunc: func() (bool, error) {
+					return !testProvider.Meta().(*provider.ProviderMeta).IsAPISupported(provider.VaultVersion112), nil
+				},
+				Config: testNamespaceCustomMetadataUpdated(namespacePath + "-cm"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameParent, consts.FieldPath, namespacePath+"-cm"),
+					resource.TestCheckResourceAttr(resourceNameParent, "custom_metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceNameParent, "custom_metadata.foo", "xyz"),
+				),
+			},
 		},
 	})
 }
@@ -185,3 +196,14 @@ resource "vault_namespace" "parent" {
 }
 `, path)
 }
+
+func testNamespaceCustomMetadataUpdated(path string) string {
+	return fmt.Sprintf(`
+resource "vault_namespace" "parent" {
+  path            = %q
+  custom_metadata = {
+    foo = "xyz"
+  }
+}
+`, path)
+}
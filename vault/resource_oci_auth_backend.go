@@ -85,6 +85,10 @@ func ociAuthBackendWrite(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	log.Printf("[DEBUG] Enabled %s auth backend %q", consts.AuthMethodOCI, path)
 
+	if err := waitForPath(ctx, meta, client, "sys/auth/"+path); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(path)
 
 	return ociAuthBackendUpdate(ctx, d, meta)
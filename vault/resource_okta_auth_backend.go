@@ -265,6 +265,10 @@ func oktaAuthBackendWrite(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	log.Printf("[INFO] Enabled okta auth backend at '%s'", path)
 
+	if err := waitForPath(ctx, meta, client, "sys/auth/"+path); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(path)
 	return oktaAuthBackendUpdate(ctx, d, meta)
 }
@@ -329,7 +329,14 @@ func pkiCertAutoRenewCustomizeDiff(_ context.Context, d *schema.ResourceDiff, me
 	if d.Id() == "" || !d.Get(consts.FieldAutoRenew).(bool) {
 		return nil
 	}
-	if d.Get(consts.FieldRenewPending).(bool) {
+	// renew_pending was computed against the prior min_seconds_remaining,
+	// so the expiration is checked again in case the window was changed
+	renewPending := d.Get(consts.FieldRenewPending).(bool)
+	if !renewPending && d.HasChange(consts.FieldMinSecondsRemaining) {
+		renewPending = pkiCertRenewPending(d.Get(consts.FieldExpiration).(int), d.Get(consts.FieldMinSecondsRemaining).(int))
+	}
+
+	if renewPending {
 		log.Printf("[DEBUG] certificate %q is due for renewal", d.Id())
 		if err := d.SetNewComputed(consts.FieldCertificate); err != nil {
 			return err
@@ -385,7 +392,7 @@ func pkiSecretBackendCertUpdate(ctx context.Context, d *schema.ResourceData, m i
 	return nil
 }
 
-func pkiSecretBackendCertDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func pkiSecretBackendCertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var revokeWithKey bool
 	if d.Get(consts.FieldRevokeWithKey) != nil {
 		revokeWithKey = d.Get(consts.FieldRevokeWithKey).(bool)
@@ -414,7 +421,7 @@ func pkiSecretBackendCertDelete(_ context.Context, d *schema.ResourceData, meta
 
 		log.Printf("[DEBUG] Revoking certificate %q with serial number %q on PKI secret backend %q",
 			commonName, serialNumber, backend)
-		_, err := client.Logical().Write(path, data)
+		_, err := client.Logical().WriteWithContext(ctx, path, data)
 
 		if err != nil {
 			return diag.Errorf("error revoking certificate %q with serial number %q for PKI secret backend %q: %s",
@@ -448,8 +455,17 @@ func pkiSecretBackendCertSynchronizeRenewPending(d *schema.ResourceData) error {
 
 	expiration := d.Get(consts.FieldExpiration).(int)
 	earlyRenew := d.Get(consts.FieldMinSecondsRemaining).(int)
-	effectiveExpiration := int64(expiration - earlyRenew)
-	return d.Set(consts.FieldRenewPending, checkPKICertExpiry(effectiveExpiration))
+	return d.Set(consts.FieldRenewPending, pkiCertRenewPending(expiration, earlyRenew))
+}
+
+// pkiCertRenewPending returns true if the current time is within
+// minSecondsRemaining seconds of the expiration time of the certificate.
+func pkiCertRenewPending(expiration, minSecondsRemaining int) bool {
+	if expiration == 0 {
+		return false
+	}
+
+	return checkPKICertExpiry(int64(expiration - minSecondsRemaining))
 }
 
 func checkPKICertExpiry(expiration int64) bool {
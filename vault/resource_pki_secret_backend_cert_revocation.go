@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func pkiSecretBackendCertRevocationResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: pkiSecretBackendCertRevocationCreate,
+		ReadContext:   provider.ReadContextWrapper(pkiSecretBackendCertRevocationRead),
+		DeleteContext: pkiSecretBackendCertRevocationDelete,
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The PKI secret backend the certificate belongs to.",
+			},
+			consts.FieldSerialNumber: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The serial number of the certificate to revoke, colon or hyphen separated.",
+			},
+			consts.FieldPrivateKeyWO: {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Description: "The private key of the certificate, proves the ownership of the certificate " +
+					"instead of requiring permissions on the revoke endpoint.",
+			},
+			consts.FieldRevocationTime: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The revocation time of the certificate as a Unix-style timestamp.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendCertRevocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := strings.Trim(d.Get(consts.FieldBackend).(string), "/")
+	serialNumber := d.Get(consts.FieldSerialNumber).(string)
+
+	data := map[string]interface{}{
+		consts.FieldSerialNumber: serialNumber,
+	}
+
+	path := backend + "/revoke"
+	if v, _ := d.GetRawConfigAt(cty.GetAttrPath(consts.FieldPrivateKeyWO)); v.IsKnown() && !v.IsNull() {
+		data[consts.FieldPrivateKey] = v.AsString()
+		path = backend + "/revoke-with-key"
+	}
+
+	log.Printf("[DEBUG] Revoking certificate with serial number %q on PKI secret backend %q", serialNumber, backend)
+	if _, err := client.Logical().WriteWithContext(ctx, path, data); err != nil {
+		return diag.Errorf("error revoking certificate with serial number %q for PKI secret backend %q: %s",
+			serialNumber, backend, err)
+	}
+	log.Printf("[DEBUG] Revoked certificate with serial number %q on PKI secret backend %q", serialNumber, backend)
+
+	d.SetId(fmt.Sprintf("%s/cert/%s", backend, serialNumber))
+
+	return pkiSecretBackendCertRevocationRead(ctx, d, meta)
+}
+
+func pkiSecretBackendCertRevocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	resp, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return diag.Errorf("error reading certificate from %q: %s", path, err)
+	}
+
+	// the certificate was removed by a tidy operation, since a revocation
+	// cannot be undone the last known state is kept
+	if resp == nil {
+		log.Printf("[WARN] Certificate %q not found, keeping the last known revocation state", path)
+		return nil
+	}
+
+	var revocationTime int64
+	if v, ok := resp.Data[consts.FieldRevocationTime].(json.Number); ok {
+		revocationTime, err = v.Int64()
+		if err != nil {
+			return diag.Errorf("error parsing revocation time of certificate at %q: %s", path, err)
+		}
+	}
+
+	if err := d.Set(consts.FieldRevocationTime, revocationTime); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func pkiSecretBackendCertRevocationDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// Vault has no API to unrevoke a certificate, the revocation is kept.
+	return nil
+}
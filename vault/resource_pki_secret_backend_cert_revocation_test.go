@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestPkiSecretBackendCertRevocation_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-pki-backend")
+	resourceName := "vault_pki_secret_backend_cert_revocation.test"
+	dataName := "data.vault_pki_secret_backend_cert.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy:             testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendCertRevocationConfig(backend, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldBackend, backend),
+					resource.TestCheckResourceAttrPair(resourceName, consts.FieldSerialNumber,
+						"vault_pki_secret_backend_cert.test", consts.FieldSerialNumber),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldRevocationTime),
+					resource.TestCheckResourceAttr(dataName, consts.FieldRevoked, "true"),
+					resource.TestCheckResourceAttrPair(dataName, consts.FieldRevocationTime,
+						resourceName, consts.FieldRevocationTime),
+				),
+			},
+			{
+				Config: testPkiSecretBackendCertRevocationConfig(backend, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldRevocationTime),
+					resource.TestCheckResourceAttr(dataName, consts.FieldRevoked, "true"),
+				),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendCertRevocationConfig(backend string, withKey bool) string {
+	privateKey := ""
+	if withKey {
+		privateKey = "private_key_wo = vault_pki_secret_backend_cert.test.private_key"
+	}
+
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "my.domain"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend          = vault_pki_secret_backend_root_cert.test.backend
+  name             = "test"
+  allowed_domains  = ["test.my.domain"]
+  allow_subdomains = true
+}
+
+resource "vault_pki_secret_backend_cert" "test" {
+  backend     = vault_pki_secret_backend_role.test.backend
+  name        = vault_pki_secret_backend_role.test.name
+  common_name = "cert.test.my.domain"
+  ttl         = "1h"
+}
+
+resource "vault_pki_secret_backend_cert_revocation" "test" {
+  backend       = vault_pki_secret_backend_cert.test.backend
+  serial_number = vault_pki_secret_backend_cert.test.serial_number
+  %s
+}
+
+data "vault_pki_secret_backend_cert" "test" {
+  backend = vault_pki_secret_backend_cert_revocation.test.backend
+  serial  = vault_pki_secret_backend_cert_revocation.test.serial_number
+}
+`, backend, privateKey)
+}
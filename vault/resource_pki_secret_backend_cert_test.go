@@ -487,3 +487,47 @@ func testPKICertReIssued(resourceName string, store *testPKICertStore) resource.
 		return nil
 	}
 }
+
+func TestPkiCertRenewPending(t *testing.T) {
+	now := int(time.Now().Unix())
+
+	tests := []struct {
+		name                string
+		expiration          int
+		minSecondsRemaining int
+		want                bool
+	}{
+		{
+			name:                "outside-window",
+			expiration:          now + 3600,
+			minSecondsRemaining: 60,
+			want:                false,
+		},
+		{
+			name:                "within-window",
+			expiration:          now + 3600,
+			minSecondsRemaining: 7200,
+			want:                true,
+		},
+		{
+			name:                "expired",
+			expiration:          now - 60,
+			minSecondsRemaining: 0,
+			want:                true,
+		},
+		{
+			name:                "no-expiration",
+			expiration:          0,
+			minSecondsRemaining: 7200,
+			want:                false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pkiCertRenewPending(tt.expiration, tt.minSecondsRemaining); got != tt.want {
+				t.Errorf("pkiCertRenewPending() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
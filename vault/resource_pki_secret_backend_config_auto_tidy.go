@@ -78,6 +78,7 @@ func pkiSecretBackendConfigAutoTidySchema() map[string]*schema.Schema {
 		consts.FieldBackend: {
 			Type:        schema.TypeString,
 			Required:    true,
+			ForceNew:    true,
 			Description: "The path of the PKI secret backend the resource belongs to.",
 		},
 	}
@@ -166,7 +167,7 @@ func pkiSecretBackendConfigAutoTidyCreateUpdate(ctx context.Context, d *schema.R
 	}
 
 	log.Printf("[DEBUG] %s auto tidy config on PKI secret backend %q", action, backend)
-	_, err := client.Logical().Write(path, data)
+	_, err := client.Logical().WriteWithContext(ctx, path, data)
 	if err != nil {
 		return diag.Errorf("error writing PKI auto tidy config to %q: %s", backend, err)
 	}
@@ -179,7 +180,7 @@ func pkiSecretBackendConfigAutoTidyCreateUpdate(ctx context.Context, d *schema.R
 	return pkiSecretBackendConfigAutoTidyRead(ctx, d, meta)
 }
 
-func pkiSecretBackendConfigAutoTidyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func pkiSecretBackendConfigAutoTidyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, e := provider.GetClient(d, meta)
 	if e != nil {
 		return diag.FromErr(e)
@@ -200,7 +201,7 @@ func pkiSecretBackendConfigAutoTidyRead(_ context.Context, d *schema.ResourceDat
 	}
 
 	log.Printf("[DEBUG] Reading auto tidy config from PKI secret path %q", path)
-	resp, err := client.Logical().Read(path)
+	resp, err := client.Logical().ReadWithContext(ctx, path)
 	if err != nil {
 		return diag.Errorf("error reading auto tidy config on PKI secret backend %q: %s", path, err)
 	}
@@ -222,8 +223,12 @@ func pkiSecretBackendConfigAutoTidyRead(_ context.Context, d *schema.ResourceDat
 	return nil
 }
 
-func pkiSecretBackendConfigAutoTidyDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	return nil
+// pkiSecretBackendConfigAutoTidyDelete disables auto-tidy, the config itself
+// can not be deleted.
+func pkiSecretBackendConfigAutoTidyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return pkiSecretBackendDisableConfig(ctx, d, meta, "auto tidy", map[string]interface{}{
+		consts.FieldEnabled: false,
+	})
 }
 
 func pkiSecretBackendConfigAutoTidyPath(backend string) string {
@@ -302,6 +302,11 @@ safety_buffer = "59000s"
 			getImportTestStep(provider.VaultVersion118),
 			getImportTestStep(provider.VaultVersion117, consts.FieldTidyCmpv2NonceStore, consts.FieldMinStartupBackoffDuration, consts.FieldMaxStartupBackoffDuration),
 			getImportTestStep(nil, consts.FieldTidyCertMetadata, consts.FieldTidyCmpv2NonceStore, consts.FieldMinStartupBackoffDuration, consts.FieldMaxStartupBackoffDuration),
+			{
+				// ensure auto-tidy is disabled when the resource is destroyed
+				Config: testAccPKISecretBackendConfigMount(backend),
+				Check:  testCheckPKIConfigDisabled(pkiSecretBackendConfigAutoTidyPath(backend), consts.FieldEnabled),
+			},
 		},
 	})
 }
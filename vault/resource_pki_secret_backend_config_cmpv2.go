@@ -157,7 +157,10 @@ func pkiSecretBackendConfigCMPV2Read(ctx context.Context, d *schema.ResourceData
 	return nil
 }
 
-func pkiSecretBackendConfigCMPV2Delete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	// There isn't any delete API for the CMPv2 config.
-	return nil
+func pkiSecretBackendConfigCMPV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// There isn't any delete API for the CMPv2 config, CMPv2 is disabled
+	// instead.
+	return pkiSecretBackendDisableConfig(ctx, d, meta, "CMPv2", map[string]interface{}{
+		consts.FieldEnabled: false,
+	})
 }
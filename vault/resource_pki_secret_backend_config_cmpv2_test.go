@@ -162,6 +162,11 @@ func TestAccPKISecretBackendConfigCMPV2_AllFields(t *testing.T) {
 				),
 			},
 			testutil.GetImportTestStep(resourceBackend, false, nil),
+			{
+				// ensure CMPv2 is disabled when the resource is destroyed
+				Config: testAccPKISecretBackendConfigMount(backend),
+				Check:  testCheckPKIConfigDisabled(backend+"/config/cmp", consts.FieldEnabled),
+			},
 		},
 	})
 }
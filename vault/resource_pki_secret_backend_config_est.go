@@ -163,7 +163,13 @@ func pkiSecretBackendConfigEstRead(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
-func pkiSecretBackendConfigEstDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	// There isn't any delete API for the EST config.
-	return nil
+func pkiSecretBackendConfigEstDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// There isn't any delete API for the EST config. EST is disabled instead,
+	// and the default mount and labels are released since they must be
+	// unique across the Vault cluster.
+	return pkiSecretBackendDisableConfig(ctx, d, meta, "EST", map[string]interface{}{
+		consts.FieldEnabled:           false,
+		consts.FieldDefaultMount:      false,
+		consts.FieldLabelToPathPolicy: map[string]interface{}{},
+	})
 }
@@ -177,6 +177,11 @@ func TestAccPKISecretBackendConfigEst_AllFields(t *testing.T) {
 				),
 			},
 			testutil.GetImportTestStep(resourceBackend, false, nil),
+			{
+				// ensure EST and the default mount are disabled when the resource is destroyed
+				Config: testAccPKISecretBackendConfigMount(backend),
+				Check:  testCheckPKIConfigDisabled(backend+"/config/est", consts.FieldEnabled, consts.FieldDefaultMount),
+			},
 		},
 	})
 }
@@ -41,6 +41,7 @@ func pkiSecretBackendConfigIssuers() *schema.Resource {
 			consts.FieldDefault: {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "Specifies the default issuer by ID.",
 			},
 			fieldDefaultFollowsLatestIssuer: {
@@ -69,9 +70,14 @@ func pkiSecretBackendConfigIssuersCreateUpdate(ctx context.Context, d *schema.Re
 		fieldDefaultFollowsLatestIssuer,
 	}
 
+	// only the configured fields are written, so that the default issuer is
+	// left as is when only default_follows_latest_issuer is managed
 	data := map[string]interface{}{}
+	rawConfig := d.GetRawConfig()
 	for _, k := range fields {
-		data[k] = d.Get(k)
+		if !rawConfig.GetAttr(k).IsNull() {
+			data[k] = d.Get(k)
+		}
 	}
 
 	_, err := client.Logical().WriteWithContext(ctx, path, data)
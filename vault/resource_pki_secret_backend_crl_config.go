@@ -165,7 +165,7 @@ func pkiSecretBackendCrlConfigRead(ctx context.Context, d *schema.ResourceData,
 
 	if _, ok := d.GetOk(consts.FieldBackend); !ok {
 		// ensure that the backend is set on import
-		if err := d.Set(consts.FieldBackend, strings.TrimRight(path, crlConfigPathBase)); err != nil {
+		if err := d.Set(consts.FieldBackend, pkiSecretBackendFromCrlConfigPath(path)); err != nil {
 			return diag.Errorf("failed setting field %s: %v", consts.FieldBackend, err)
 		}
 	}
@@ -207,6 +207,11 @@ func pkiSecretBackendCrlConfigPath(backend string) string {
 	return strings.Trim(backend, "/") + crlConfigPathBase
 }
 
+// pkiSecretBackendFromCrlConfigPath returns the backend from the CRL config path.
+func pkiSecretBackendFromCrlConfigPath(path string) string {
+	return strings.TrimSuffix(path, crlConfigPathBase)
+}
+
 func buildConfigCRLFields(meta interface{}) []string {
 	fields := []string{
 		"expiry",
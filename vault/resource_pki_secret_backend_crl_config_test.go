@@ -318,3 +318,20 @@ func getCRLConfigZeroChecks(resourceName string, isZeroVal bool) resource.TestCh
 		return resource.ComposeAggregateTestCheckFunc(checks...)(state)
 	}
 }
+
+func TestPkiSecretBackendFromCrlConfigPath(t *testing.T) {
+	tests := map[string]string{
+		"pki/config/crl":           "pki",
+		"pki-root-1234/config/crl": "pki-root-1234",
+		"ns/nested/pki/config/crl": "ns/nested/pki",
+		"config/config/crl":        "config",
+	}
+
+	for path, want := range tests {
+		t.Run(path, func(t *testing.T) {
+			if got := pkiSecretBackendFromCrlConfigPath(path); got != want {
+				t.Errorf("pkiSecretBackendFromCrlConfigPath() = %q, want %q", got, want)
+			}
+		})
+	}
+}
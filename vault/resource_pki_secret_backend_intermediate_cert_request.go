@@ -325,7 +325,7 @@ func pkiSecretBackendIntermediateCertRequestCreate(ctx context.Context, d *schem
 	}
 
 	log.Printf("[DEBUG] Creating intermediate cert request on PKI secret backend %q", backend)
-	resp, err := client.Logical().Write(path, data)
+	resp, err := client.Logical().WriteWithContext(ctx, path, data)
 	if err != nil {
 		return diag.Errorf("error creating intermediate cert request for PKI secret backend %q: %s", backend, err)
 	}
@@ -72,7 +72,7 @@ func pkiSecretBackendIntermediateSetSignedCreate(ctx context.Context, d *schema.
 	}
 
 	log.Printf("[DEBUG] Creating intermediate set-signed on PKI secret backend %q", backend)
-	resp, err := client.Logical().Write(path, data)
+	resp, err := client.Logical().WriteWithContext(ctx, path, data)
 	if err != nil {
 		return diag.Errorf("error creating intermediate set-signed on PKI secret backend %q: %s", backend, err)
 	}
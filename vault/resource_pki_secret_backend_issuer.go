@@ -11,9 +11,11 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
 )
 
 var (
@@ -46,7 +48,7 @@ func pkiSecretBackendIssuerResource() *schema.Resource {
 			consts.FieldIssuerName: {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Reference to an existing issuer.",
+				Description: "Name of the issuer.",
 			},
 			consts.FieldLeafNotAfterBehavior: {
 				Type:     schema.TypeString,
@@ -147,16 +149,8 @@ func pkiSecretBackendIssuerCreate(ctx context.Context, d *schema.ResourceData, m
 	path := fmt.Sprintf("%s/issuer/%s", backend, issuerRef)
 
 	// ensure given issuer ref exists before attempting to update fields
-	resp, err := client.Logical().ReadWithContext(ctx, path)
-	if err != nil {
-		return diag.Errorf("error reading issuer at %s, err=%s", path, err)
-	}
-
-	if resp == nil {
-		// since this resource is specifically designed to track
-		// and cleanup existing issuers in Vault, we return an error
-		// instead of setting ID to "" if no issuer is found
-		return diag.Errorf("no issuer found at path %s", path)
+	if err := pkiSecretBackendIssuerExists(ctx, client, path); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId(path)
@@ -172,6 +166,37 @@ func pkiSecretBackendIssuerUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	path := d.Id()
 
+	// the issuer_ref can be changed to track another issuer in the same backend,
+	// in which case all configured fields are written to the new issuer.
+	retarget := !d.IsNewResource() && d.HasChange(consts.FieldIssuerRef)
+	if retarget {
+		backend, err := pkiSecretBackendFromIssuerPath(path)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		path = fmt.Sprintf("%s/issuer/%s", backend, d.Get(consts.FieldIssuerRef).(string))
+		if err := pkiSecretBackendIssuerExists(ctx, client, path); err != nil {
+			return diag.FromErr(err)
+		}
+
+		// issuer names must be unique within the backend, so the name must be
+		// removed from the previous issuer before it can be set on the new one.
+		oldName, newName := d.GetChange(consts.FieldIssuerName)
+		if oldName.(string) != "" && oldName == newName {
+			oldPath := d.Id()
+			log.Printf("[DEBUG] Removing the issuer name %q from %q", oldName, oldPath)
+			_, err := client.Logical().JSONMergePatch(ctx, oldPath, map[string]interface{}{
+				consts.FieldIssuerName: "",
+			})
+			if err != nil && !(util.Is500(err) && util.ErrorContainsString(err, issuerNotFoundErr)) {
+				return diag.Errorf("error removing the issuer name from %q, err=%s", oldPath, err)
+			}
+		}
+
+		d.SetId(path)
+	}
+
 	configurableFields := []string{
 		consts.FieldIssuerName,
 		consts.FieldLeafNotAfterBehavior,
@@ -187,8 +212,9 @@ func pkiSecretBackendIssuerUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	var patchRequired bool
 	data := map[string]interface{}{}
+	rawConfig := d.GetRawConfig()
 	for _, k := range configurableFields {
-		if d.HasChange(k) {
+		if d.HasChange(k) || (retarget && !rawConfig.GetAttr(k).IsNull()) {
 			data[k] = d.Get(k)
 			patchRequired = true
 		}
@@ -233,15 +259,20 @@ func pkiSecretBackendIssuerRead(ctx context.Context, d *schema.ResourceData, met
 
 	log.Printf("[DEBUG] Reading %s from Vault", path)
 	resp, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		// Vault returns a 500 for issuers that no longer exist
+		if !(util.Is500(err) && util.ErrorContainsString(err, issuerNotFoundErr)) {
+			return diag.Errorf("error reading from Vault: %s", err)
+		}
+		resp = nil
+	}
+
 	if resp == nil {
+		log.Printf("[WARN] PKI issuer %q not found, removing from state", path)
 		d.SetId("")
 		return nil
 	}
 
-	if err != nil {
-		return diag.Errorf("error reading from Vault: %s", err)
-	}
-
 	// set backend and issuerRef
 	if err := d.Set(consts.FieldBackend, backend); err != nil {
 		return diag.FromErr(err)
@@ -294,6 +325,22 @@ func pkiSecretBackendIssuerDelete(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+func pkiSecretBackendIssuerExists(ctx context.Context, client *api.Client, path string) error {
+	resp, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return fmt.Errorf("error reading issuer at %s, err=%s", path, err)
+	}
+
+	if resp == nil {
+		// since this resource is specifically designed to track
+		// and cleanup existing issuers in Vault, we return an error
+		// instead of setting ID to "" if no issuer is found
+		return fmt.Errorf("no issuer found at path %s", path)
+	}
+
+	return nil
+}
+
 func pkiSecretBackendFromIssuerPath(path string) (string, error) {
 	if !pkiSecretBackendFromIssuerPathRegex.MatchString(path) {
 		return "", fmt.Errorf("no backend found")
@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func pkiSecretBackendIssuerCrossSignResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: provider.MountCreateContextWrapper(pkiSecretBackendIssuerCrossSignCreate, provider.VaultVersion111),
+		ReadContext:   provider.ReadContextWrapper(pkiSecretBackendIssuerCrossSignRead),
+		DeleteContext: pkiSecretBackendIssuerCrossSignDelete,
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The PKI secret backend of the issuer to cross-sign.",
+			},
+			consts.FieldIssuerRef: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Reference to the existing issuer to cross-sign.",
+			},
+			consts.FieldSigningBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The PKI secret backend of the issuer that signs the cross-signed certificate.",
+			},
+			consts.FieldSigningIssuerRef: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Reference to the issuer that signs the cross-signed certificate, defaults to the default issuer of the signing backend.",
+			},
+			consts.FieldTTL: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Time to live of the cross-signed certificate.",
+			},
+			consts.FieldCertificate: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The cross-signed certificate.",
+			},
+			consts.FieldIssuingCA: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The issuing CA of the cross-signed certificate.",
+			},
+			consts.FieldCAChain: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The CA chain of the cross-signed certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			consts.FieldSerialNumber: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The serial number of the cross-signed certificate.",
+			},
+			consts.FieldImportedIssuers: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The issuers that were imported into the backend for the cross-signed certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// pkiSecretBackendIssuerCrossSignCreate generates a CSR with the key of the
+// existing issuer, signs it with the signing issuer using the values of the
+// CSR, and imports the signed certificate back into the backend as a new
+// issuer that shares the key and subject of the existing issuer.
+func pkiSecretBackendIssuerCrossSignCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := strings.Trim(d.Get(consts.FieldBackend).(string), "/")
+	issuerRef := d.Get(consts.FieldIssuerRef).(string)
+	signingBackend := d.Get(consts.FieldSigningBackend).(string)
+
+	issuerPath := fmt.Sprintf("%s/issuer/%s", backend, issuerRef)
+	resp, err := client.Logical().ReadWithContext(ctx, issuerPath)
+	if err != nil {
+		return diag.Errorf("error reading issuer at %q: %s", issuerPath, err)
+	}
+	if resp == nil {
+		return diag.Errorf("no issuer found at path %q", issuerPath)
+	}
+
+	cert, err := pkiParseCertificatePEM(resp.Data[consts.FieldCertificate])
+	if err != nil {
+		return diag.Errorf("error parsing the certificate of issuer %q: %s", issuerPath, err)
+	}
+
+	// the CSR must have the same subject as the existing issuer, so that the
+	// cross-signed certificate can be used interchangeably
+	csrData := pkiSubjectToRequestData(cert)
+	csrData[consts.FieldKeyRef] = resp.Data[consts.FieldKeyID]
+
+	csrPath := pkiSecretBackendIntermediateGeneratePath(backend, consts.FieldExisting, true)
+	log.Printf("[DEBUG] Generating cross-sign CSR for issuer %q", issuerPath)
+	csrResp, err := client.Logical().WriteWithContext(ctx, csrPath, csrData)
+	if err != nil {
+		return diag.Errorf("error generating cross-sign CSR for issuer %q: %s", issuerPath, err)
+	}
+
+	signData := map[string]interface{}{
+		consts.FieldCSR:          csrResp.Data[consts.FieldCSR],
+		consts.FieldCommonName:   cert.Subject.CommonName,
+		consts.FieldUseCSRValues: true,
+	}
+	if v, ok := d.GetOk(consts.FieldTTL); ok {
+		signData[consts.FieldTTL] = v
+	}
+
+	signPath := pkiSecretBackendRootSignIntermediateCreatePath(signingBackend, d.Get(consts.FieldSigningIssuerRef).(string))
+	log.Printf("[DEBUG] Cross-signing issuer %q on PKI secret backend %q", issuerPath, signingBackend)
+	signResp, err := client.Logical().WriteWithContext(ctx, signPath, signData)
+	if err != nil {
+		return diag.Errorf("error cross-signing issuer %q on PKI secret backend %q: %s", issuerPath, signingBackend, err)
+	}
+
+	setSignedPath := pkiSecretBackendIntermediateSetSignedCreatePath(backend)
+	log.Printf("[DEBUG] Importing cross-signed certificate for issuer %q", issuerPath)
+	importResp, err := client.Logical().WriteWithContext(ctx, setSignedPath, map[string]interface{}{
+		consts.FieldCertificate: signResp.Data[consts.FieldCertificate],
+	})
+	if err != nil {
+		return diag.Errorf("error importing cross-signed certificate for issuer %q: %s", issuerPath, err)
+	}
+	log.Printf("[DEBUG] Imported cross-signed certificate for issuer %q", issuerPath)
+
+	for _, k := range []string{
+		consts.FieldCertificate,
+		consts.FieldIssuingCA,
+		consts.FieldCAChain,
+		consts.FieldSerialNumber,
+	} {
+		if err := d.Set(k, signResp.Data[k]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var importedIssuers interface{}
+	if importResp != nil {
+		importedIssuers = importResp.Data[consts.FieldImportedIssuers]
+	}
+	if err := d.Set(consts.FieldImportedIssuers, importedIssuers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/cross-sign/%s", backend, signResp.Data[consts.FieldSerialNumber]))
+
+	return pkiSecretBackendIssuerCrossSignRead(ctx, d, meta)
+}
+
+func pkiSecretBackendIssuerCrossSignRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := d.Get(consts.FieldBackend).(string)
+
+	importedIssuers := util.ToStringArray(d.Get(consts.FieldImportedIssuers).([]interface{}))
+	if len(importedIssuers) == 0 {
+		// the cross-signed certificate was already imported, there is
+		// nothing to track
+		return nil
+	}
+
+	// the resource is gone once all imported issuers were deleted
+	for _, issuerID := range importedIssuers {
+		exists, err := pkiIssuerExists(ctx, client, backend, issuerID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if exists {
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] Cross-signed issuers on %q not found, removing from state", backend)
+	d.SetId("")
+
+	return nil
+}
+
+func pkiSecretBackendIssuerCrossSignDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := d.Get(consts.FieldBackend).(string)
+
+	for _, issuerID := range util.ToStringArray(d.Get(consts.FieldImportedIssuers).([]interface{})) {
+		path := fmt.Sprintf("%s/issuer/%s", backend, issuerID)
+
+		log.Printf("[DEBUG] Deleting cross-signed issuer %q", path)
+		if _, err := client.Logical().DeleteWithContext(ctx, path); err != nil && !util.Is404(err) {
+			return diag.Errorf("error deleting cross-signed issuer %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Deleted cross-signed issuer %q", path)
+	}
+
+	return nil
+}
+
+// pkiIssuerExists returns true if the issuer exists on the backend.
+func pkiIssuerExists(ctx context.Context, client *api.Client, backend, issuerRef string) (bool, error) {
+	path := fmt.Sprintf("%s/issuer/%s", backend, issuerRef)
+	resp, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		// Vault returns a 500 for issuers that no longer exist
+		if util.Is500(err) && util.ErrorContainsString(err, issuerNotFoundErr) {
+			return false, nil
+		}
+		return false, fmt.Errorf("error reading issuer %q: %w", path, err)
+	}
+
+	return resp != nil, nil
+}
+
+// pkiParseCertificatePEM parses the first certificate of a PEM encoded value.
+func pkiParseCertificatePEM(v interface{}) (*x509.Certificate, error) {
+	s, _ := v.(string)
+	b, _ := pem.Decode([]byte(s))
+	if b == nil {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+
+	return x509.ParseCertificate(b.Bytes)
+}
+
+// pkiSubjectToRequestData returns the subject fields of the certificate as
+// PKI request data.
+func pkiSubjectToRequestData(cert *x509.Certificate) map[string]interface{} {
+	data := map[string]interface{}{
+		consts.FieldCommonName: cert.Subject.CommonName,
+	}
+
+	fields := map[string][]string{
+		consts.FieldOu:            cert.Subject.OrganizationalUnit,
+		consts.FieldOrganization:  cert.Subject.Organization,
+		consts.FieldCountry:       cert.Subject.Country,
+		consts.FieldLocality:      cert.Subject.Locality,
+		consts.FieldProvince:      cert.Subject.Province,
+		consts.FieldStreetAddress: cert.Subject.StreetAddress,
+		consts.FieldPostalCode:    cert.Subject.PostalCode,
+	}
+	for k, v := range fields {
+		if len(v) > 0 {
+			data[k] = strings.Join(v, ",")
+		}
+	}
+
+	return data
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccPKISecretBackendIssuerCrossSign_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-pki")
+	signingBackend := acctest.RandomWithPrefix("tf-test-pki-signing")
+	resourceName := "vault_pki_secret_backend_issuer_cross_sign.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			SkipIfAPIVersionLT(t, testProvider.Meta(), provider.VaultVersion111)
+		},
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPKISecretBackendIssuerCrossSign_basic(backend, signingBackend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldBackend, backend),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldSigningBackend, signingBackend),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldCertificate),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldSerialNumber),
+					resource.TestCheckResourceAttr(resourceName, "imported_issuers.#", "1"),
+					testPKICert(resourceName, func(cert *x509.Certificate) error {
+						if cert.Subject.CommonName != "current Root CA" {
+							return fmt.Errorf("expected subject common name %q, actual %q",
+								"current Root CA", cert.Subject.CommonName)
+						}
+						if cert.Issuer.CommonName != "next Root CA" {
+							return fmt.Errorf("expected issuer common name %q, actual %q",
+								"next Root CA", cert.Issuer.CommonName)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccPKISecretBackendIssuerCrossSign_basic(backend, signingBackend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_mount" "signing" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_root_cert" "current" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "current Root CA"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_root_cert" "next" {
+  backend     = vault_mount.signing.path
+  type        = "internal"
+  common_name = "next Root CA"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_issuer_cross_sign" "test" {
+  backend            = vault_mount.test.path
+  issuer_ref         = vault_pki_secret_backend_root_cert.current.issuer_id
+  signing_backend    = vault_mount.signing.path
+  signing_issuer_ref = vault_pki_secret_backend_root_cert.next.issuer_id
+  ttl                = "3600"
+}
+`, backend, signingBackend)
+}
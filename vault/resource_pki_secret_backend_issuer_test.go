@@ -5,12 +5,16 @@ package vault
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
@@ -196,6 +200,223 @@ func TestAccPKISecretBackendIssuer_verify_disable_fields(t *testing.T) {
 	})
 }
 
+func TestAccPKISecretBackendIssuer_issuerRef(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-pki")
+	resourceType := "vault_pki_secret_backend_issuer"
+	resourceName := resourceType + ".test"
+
+	var issuerID string
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			SkipIfAPIVersionLT(t, testProvider.Meta(), provider.VaultVersion111)
+		},
+		CheckDestroy: testCheckMountDestroyed(resourceType, consts.MountTypePKI, consts.FieldBackend),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPKISecretBackendIssuer_issuerRef(backend, "one"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, consts.FieldIssuerRef,
+						"vault_pki_secret_backend_root_cert.one", consts.FieldIssuerID),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldLeafNotAfterBehavior, "truncate"),
+					resource.TestCheckResourceAttrWith(resourceName, consts.FieldIssuerID, func(v string) error {
+						issuerID = v
+						return nil
+					}),
+				),
+			},
+			{
+				// changing the issuer_ref must update the resource in place
+				// and write the configured fields to the new issuer.
+				Config: testAccPKISecretBackendIssuer_issuerRef(backend, "two"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, consts.FieldIssuerRef,
+						"vault_pki_secret_backend_root_cert.two", consts.FieldIssuerID),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldLeafNotAfterBehavior, "truncate"),
+					resource.TestCheckResourceAttrWith(resourceName, consts.FieldIssuerID, func(v string) error {
+						if v == issuerID {
+							return fmt.Errorf("expected %s to change, got %q", consts.FieldIssuerID, v)
+						}
+						return nil
+					}),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil, consts.FieldUsage),
+		},
+	})
+}
+
+func TestPKISecretBackendIssuerRead(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		body       map[string]interface{}
+		wantID     string
+		wantErr    bool
+		wantIssuer string
+	}{
+		{
+			name:   "found",
+			status: http.StatusOK,
+			body: map[string]interface{}{
+				"data": map[string]interface{}{
+					consts.FieldIssuerName: "issuer-1",
+					consts.FieldIssuerID:   "id-1",
+				},
+			},
+			wantID:     "pki/issuer/id-1",
+			wantIssuer: "issuer-1",
+		},
+		{
+			name:   "not-found",
+			status: http.StatusNotFound,
+			body: map[string]interface{}{
+				"errors": []string{},
+			},
+			wantID: "",
+		},
+		{
+			name:   "removed-issuer",
+			status: http.StatusInternalServerError,
+			body: map[string]interface{}{
+				"errors": []string{issuerNotFoundErr + " id-1"},
+			},
+			wantID: "",
+		},
+		{
+			name:   "error",
+			status: http.StatusInternalServerError,
+			body: map[string]interface{}{
+				"errors": []string{"internal error"},
+			},
+			wantID:  "pki/issuer/id-1",
+			wantErr: true,
+		},
+		{
+			name:   "permission-denied",
+			status: http.StatusForbidden,
+			body: map[string]interface{}{
+				"errors": []string{"permission denied"},
+			},
+			wantID:  "pki/issuer/id-1",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := testMockVaultMeta(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/pki/issuer/id-1" {
+					w.WriteHeader(http.StatusNotImplemented)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				json.NewEncoder(w).Encode(tt.body)
+			})
+
+			d := pkiSecretBackendIssuerResource().TestResourceData()
+			d.SetId("pki/issuer/id-1")
+
+			diags := pkiSecretBackendIssuerRead(context.Background(), d, meta)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("pkiSecretBackendIssuerRead() error = %v, wantErr %v", diags, tt.wantErr)
+			}
+
+			if d.Id() != tt.wantID {
+				t.Errorf("pkiSecretBackendIssuerRead() id = %q, want %q", d.Id(), tt.wantID)
+			}
+
+			if got := d.Get(consts.FieldIssuerName).(string); got != tt.wantIssuer {
+				t.Errorf("pkiSecretBackendIssuerRead() %s = %q, want %q", consts.FieldIssuerName, got, tt.wantIssuer)
+			}
+		})
+	}
+}
+
+func TestPKISecretBackendIssuerUpdate_retargetIssuerName(t *testing.T) {
+	// issuer names by issuer ID, Vault rejects names already in use by
+	// another issuer.
+	issuers := map[string]string{
+		"id-1": "",
+		"id-2": "",
+	}
+
+	meta := testMockVaultMeta(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/sys/seal-status" {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				consts.FieldVersion: "1.15.0",
+			})
+			return
+		}
+
+		id := strings.TrimPrefix(r.URL.Path, "/v1/pki/issuer/")
+		if _, ok := issuers[id]; !ok {
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+
+		if r.Method != http.MethodGet {
+			var data map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			if name, ok := data[consts.FieldIssuerName].(string); ok {
+				for k, v := range issuers {
+					if k != id && name != "" && v == name {
+						w.WriteHeader(http.StatusBadRequest)
+						json.NewEncoder(w).Encode(map[string]interface{}{
+							"errors": []string{"issuer name already in use"},
+						})
+						return
+					}
+				}
+				issuers[id] = name
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				consts.FieldIssuerID:   id,
+				consts.FieldIssuerName: issuers[id],
+			},
+		})
+	})
+
+	r := pkiSecretBackendIssuerResource()
+	state := testResourceApply(t, r, nil, map[string]interface{}{
+		consts.FieldBackend:    "pki",
+		consts.FieldIssuerRef:  "id-1",
+		consts.FieldIssuerName: "main",
+	}, meta)
+
+	state = testResourceApply(t, r, state, map[string]interface{}{
+		consts.FieldBackend:    "pki",
+		consts.FieldIssuerRef:  "id-2",
+		consts.FieldIssuerName: "main",
+	}, meta)
+
+	if want := "pki/issuer/id-2"; state.ID != want {
+		t.Errorf("expected ID %q, got %q", want, state.ID)
+	}
+	if got := issuers["id-2"]; got != "main" {
+		t.Errorf("expected the new issuer to be named %q, got %q", "main", got)
+	}
+	if got := issuers["id-1"]; got != "" {
+		t.Errorf("expected the name to be removed from the previous issuer, got %q", got)
+	}
+}
+
 func testAccPKISecretBackendIssuer_basic(path, extraFields string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "test" {
@@ -217,3 +438,34 @@ resource "vault_pki_secret_backend_issuer" "test" {
   %s
 }`, path, extraFields)
 }
+
+func testAccPKISecretBackendIssuer_issuerRef(path, issuer string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path        = "%s"
+  type        = "pki"
+  description = "PKI secret engine mount"
+}
+
+resource "vault_pki_secret_backend_root_cert" "one" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "one"
+  issuer_name = "one"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_root_cert" "two" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "two"
+  issuer_name = "two"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_issuer" "test" {
+  backend                 = vault_mount.test.path
+  issuer_ref              = vault_pki_secret_backend_root_cert.%s.issuer_id
+  leaf_not_after_behavior = "truncate"
+}`, path, issuer)
+}
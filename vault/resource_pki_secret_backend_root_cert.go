@@ -45,6 +45,12 @@ func pkiSecretBackendRootCertResource() *schema.Resource {
 		},
 		SchemaVersion: 1,
 		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			// the whole root is deleted on Vault versions without multi-issuer
+			// support, so the issuer cannot be kept on destroy
+			if d.Get(consts.FieldKeepIssuerOnDestroy).(bool) && !provider.IsAPISupported(meta, provider.VaultVersion111) {
+				return fmt.Errorf("%q requires Vault %s or later", consts.FieldKeepIssuerOnDestroy, provider.VaultVersion111)
+			}
+
 			key := consts.FieldSerialNumber
 			o, _ := d.GetChange(key)
 			// skip on new resource
@@ -414,6 +420,12 @@ func pkiSecretBackendRootCertResource() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: provider.ValidateDuration,
 			},
+			consts.FieldKeepIssuerOnDestroy: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Keep the issuer in Vault when the resource is destroyed, " +
+					"it is only removed from the Terraform state. Requires Vault 1.11+.",
+			},
 		},
 	}
 }
@@ -612,6 +624,13 @@ func pkiSecretBackendRootCertDelete(ctx context.Context, d *schema.ResourceData,
 
 	backend := d.Get(consts.FieldBackend).(string)
 
+	// keep_issuer_on_destroy is rejected at plan time on Vault versions
+	// without multi-issuer support, where the whole root would be deleted
+	if d.Get(consts.FieldKeepIssuerOnDestroy).(bool) && provider.IsAPISupported(meta, provider.VaultVersion111) {
+		log.Printf("[DEBUG] Keeping issuer %q on PKI secret backend %q", d.Get(consts.FieldIssuerID), backend)
+		return nil
+	}
+
 	path := pkiSecretBackendDeleteRootPath(backend)
 
 	if provider.IsAPISupported(meta, provider.VaultVersion111) {
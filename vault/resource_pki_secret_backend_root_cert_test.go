@@ -12,6 +12,7 @@ import (
 	"github.com/go-test/deep"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"reflect"
+	"regexp"
 	"strconv"
 	"testing"
 	"time"
@@ -466,6 +467,94 @@ resource "vault_pki_secret_backend_root_cert" "test" {
 	return config
 }
 
+func TestPkiSecretBackendRootCertificate_keepIssuerOnDestroy(t *testing.T) {
+	path := acctest.RandomWithPrefix("test-pki-mount")
+	resourceName := "vault_pki_secret_backend_root_cert.test"
+
+	var issuerID string
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			SkipIfAPIVersionLT(t, testProvider.Meta(), provider.VaultVersion111)
+		},
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendRootCertificateConfig_keepIssuerOnDestroy(path, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldKeepIssuerOnDestroy, "true"),
+					func(s *terraform.State) error {
+						rs, ok := s.RootModule().Resources[resourceName]
+						if !ok {
+							return fmt.Errorf("resource %q not found in state", resourceName)
+						}
+						issuerID = rs.Primary.Attributes[consts.FieldIssuerID]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testPkiSecretBackendRootCertificateConfig_keepIssuerOnDestroy(path, false),
+				Check: func(_ *terraform.State) error {
+					client := testProvider.Meta().(*provider.ProviderMeta).MustGetClient()
+
+					resp, err := client.Logical().Read(fmt.Sprintf("%s/issuer/%s", path, issuerID))
+					if err != nil {
+						return err
+					}
+					if resp == nil {
+						return fmt.Errorf("expected issuer %q to be kept", issuerID)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestPkiSecretBackendRootCertificate_keepIssuerOnDestroyUnsupported(t *testing.T) {
+	path := acctest.RandomWithPrefix("test-pki-mount")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			SkipIfAPIVersionGTE(t, testProvider.Meta(), provider.VaultVersion111)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testPkiSecretBackendRootCertificateConfig_keepIssuerOnDestroy(path, true),
+				ExpectError: regexp.MustCompile(`"keep_issuer_on_destroy" requires Vault 1.11.0 or later`),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendRootCertificateConfig_keepIssuerOnDestroy(path string, withRootCert bool) string {
+	config := fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path        = "%s"
+  type        = "pki"
+  description = "test"
+}
+`, path)
+
+	if withRootCert {
+		config += `
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend                = vault_mount.test.path
+  type                   = "internal"
+  common_name            = "test Root CA"
+  keep_issuer_on_destroy = true
+}
+`
+	}
+
+	return config
+}
+
 func testPkiSecretBackendRootCertificateConfig_multiIssuerInternal(path, issuer, key string) string {
 	config := fmt.Sprintf(`
 resource "vault_mount" "test" {
@@ -421,7 +421,7 @@ func pkiSecretBackendRootSignIntermediateCreate(ctx context.Context, d *schema.R
 	path := pkiSecretBackendRootSignIntermediateCreatePath(backend, issuerRef)
 
 	log.Printf("[DEBUG] Creating root sign-intermediate on PKI secret backend %q", backend)
-	resp, err := client.Logical().Write(path, data)
+	resp, err := client.Logical().WriteWithContext(ctx, path, data)
 	if err != nil {
 		return diag.Errorf("error creating root sign-intermediate on PKI secret backend %q: %s", backend, err)
 	}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func pkiSecretBackendSignVerbatimResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: pkiSecretBackendSignVerbatimCreate,
+		DeleteContext: pkiSecretBackendSignDelete,
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			return nil
+		},
+		ReadContext:   provider.ReadContextWrapper(pkiSecretBackendCertRead),
+		CustomizeDiff: pkiCertAutoRenewCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+				ForceNew:    true,
+			},
+			consts.FieldName: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Name of the role whose key usages, TTL and issuer are used as defaults " +
+					"when signing the CSR.",
+				ForceNew: true,
+			},
+			consts.FieldCSR: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CSR.",
+				ForceNew:    true,
+			},
+			consts.FieldKeyUsage: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Allowed key usages for the certificate.",
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			consts.FieldExtKeyUsage: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Allowed extended key usages for the certificate.",
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			consts.FieldTTL: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    false,
+				Description: "Time to live.",
+			},
+			consts.FieldFormat: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The format of data.",
+				ForceNew:     true,
+				Default:      "pem",
+				ValidateFunc: validation.StringInSlice([]string{"pem", "der", "pem_bundle"}, false),
+			},
+			consts.FieldSignatureBits: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The number of bits to use in the signature algorithm.",
+				ForceNew:    true,
+			},
+			consts.FieldAutoRenew: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If enabled, a new certificate will be generated if the expiration is within min_seconds_remaining",
+			},
+			consts.FieldMinSecondsRemaining: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     604800,
+				Description: "Generate a new certificate when the expiration is within this number of seconds",
+			},
+			consts.FieldCertificate: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The certificate.",
+			},
+			consts.FieldIssuingCA: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The issuing CA.",
+			},
+			consts.FieldCAChain: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The CA chain.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			consts.FieldSerialNumber: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The certificate's serial number, hex formatted.",
+			},
+			consts.FieldExpiration: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The certificate expiration as a Unix-style timestamp.",
+			},
+			consts.FieldRenewPending: {
+				Type:     schema.TypeBool,
+				Computed: true,
+				Description: "Initially false, and then set to true during refresh once " +
+					"the expiration is less than min_seconds_remaining in the future.",
+			},
+			consts.FieldIssuerRef: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the issuer to sign the CSR with.",
+				ForceNew:    true,
+			},
+			consts.FieldNotAfter: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Set the Not After field of the certificate with specified date value. " +
+					"The value format should be given in UTC format YYYY-MM-ddTHH:MM:SSZ. Supports the " +
+					"Y10K end date for IEEE 802.1AR-2018 standard devices, 9999-12-31T23:59:59Z.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendSignVerbatimCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := d.Get(consts.FieldBackend).(string)
+	name := d.Get(consts.FieldName).(string)
+
+	var issuerRef string
+	if provider.IsAPISupported(meta, provider.VaultVersion111) {
+		issuerRef = d.Get(consts.FieldIssuerRef).(string)
+	}
+
+	path := pkiSecretBackendSignVerbatimPath(backend, issuerRef, name)
+
+	signVerbatimAPIFields := []string{
+		consts.FieldCSR,
+		consts.FieldTTL,
+		consts.FieldFormat,
+		consts.FieldNotAfter,
+		consts.FieldSignatureBits,
+	}
+
+	signVerbatimStringArrayAPIFields := []string{
+		consts.FieldKeyUsage,
+		consts.FieldExtKeyUsage,
+	}
+
+	data := map[string]interface{}{}
+	for _, k := range signVerbatimAPIFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	for _, k := range signVerbatimStringArrayAPIFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Creating certificate sign-verbatim on PKI secret backend %q", backend)
+	resp, err := client.Logical().WriteWithContext(ctx, path, data)
+	if err != nil {
+		return diag.Errorf("error creating certificate sign-verbatim for PKI secret backend %q: %s",
+			backend, err)
+	}
+	log.Printf("[DEBUG] Created certificate sign-verbatim on PKI secret backend %q", backend)
+
+	certFieldsMap := map[string]string{
+		consts.FieldCertificate:  consts.FieldCertificate,
+		consts.FieldIssuingCA:    consts.FieldIssuingCA,
+		consts.FieldCAChain:      consts.FieldCAChain,
+		consts.FieldSerialNumber: consts.FieldSerialNumber,
+		consts.FieldExpiration:   consts.FieldExpiration,
+	}
+
+	for k, v := range certFieldsMap {
+		if err := d.Set(k, resp.Data[v]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := pkiSecretBackendCertSynchronizeRenewPending(d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/sign-verbatim/%s", backend, resp.Data[consts.FieldSerialNumber]))
+
+	return pkiSecretBackendCertRead(ctx, d, meta)
+}
+
+func pkiSecretBackendSignVerbatimPath(backend, issuerRef, name string) string {
+	path := strings.Trim(backend, "/")
+	if issuerRef != "" {
+		path += "/issuer/" + strings.Trim(issuerRef, "/")
+	}
+	path += "/sign-verbatim"
+	if name != "" {
+		path += "/" + strings.Trim(name, "/")
+	}
+
+	return path
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestPkiSecretBackendSignVerbatim_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-pki")
+	resourceName := "vault_pki_secret_backend_sign_verbatim.test"
+
+	csr := testPKIGenerateCSR(t, "verbatim.example.com")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy:             testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendSignVerbatimConfig_basic(path, csr),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldBackend, path),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldTTL, "1h"),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldCertificate),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldIssuingCA),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldSerialNumber),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldExpiration),
+					testPKICert(resourceName, func(cert *x509.Certificate) error {
+						if cert.Subject.CommonName != "verbatim.example.com" {
+							return fmt.Errorf("expected common name %q, actual %q", "verbatim.example.com", cert.Subject.CommonName)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+// testPKIGenerateCSR returns a PEM encoded CSR for a new ECDSA key.
+func testPKIGenerateCSR(t *testing.T, commonName string) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: commonName},
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+}
+
+func testPkiSecretBackendSignVerbatimConfig_basic(path, csr string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path                      = "%s"
+  type                      = "pki"
+  description               = "test"
+  default_lease_ttl_seconds = "86400"
+  max_lease_ttl_seconds     = "86400"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "my.domain"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_sign_verbatim" "test" {
+  backend = vault_pki_secret_backend_root_cert.test.backend
+  csr     = <<EOT
+%sEOT
+  ttl     = "1h"
+}
+`, path, csr)
+}
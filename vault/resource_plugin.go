@@ -182,7 +182,7 @@ func pluginRead(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	typ, name, version := pluginFromID(d.Id())
 	if typ == "" || name == "" {
-		diag.Errorf("invalid ID %q, must be of form :type/name/:name or :type/version/:version/name/:name", d.Id())
+		return diag.Errorf("invalid ID %q, must be of form :type/name/:name or :type/version/:version/name/:name", d.Id())
 	}
 
 	if diagErr := versionedPluginsSupported(meta, version); diagErr != nil {
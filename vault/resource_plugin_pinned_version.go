@@ -86,9 +86,16 @@ func pluginPinnedVersionRead(ctx context.Context, d *schema.ResourceData, meta i
 	}
 	typ, name := parts[0], parts[1]
 
+	// imported pins are read before any create or update version check
+	if !provider.IsAPISupported(meta, provider.VaultVersion116) {
+		return diag.Errorf("feature not enabled on current Vault version. min version required=%s; "+
+			"current vault version=%s", provider.VaultVersion116, meta.(*provider.ProviderMeta).GetVaultVersion())
+	}
+
 	resp, err := client.Logical().ReadWithContext(ctx, idToPath(d.Id()))
 
-	if err != nil && util.Is404(err) {
+	// the client returns a nil response without an error for a 404
+	if (err != nil && util.Is404(err)) || (err == nil && resp == nil) {
 		log.Printf("[WARN] pinned plugin version %q not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
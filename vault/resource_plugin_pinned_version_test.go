@@ -5,11 +5,14 @@ package vault
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 
@@ -65,3 +68,85 @@ resource "vault_plugin_pinned_version" "test" {
 
 	return ret
 }
+
+func TestPluginPinnedVersionRead(t *testing.T) {
+	tests := []struct {
+		name         string
+		vaultVersion string
+		status       int
+		wantErr      bool
+		wantID       string
+		wantVersion  string
+	}{
+		{
+			name:         "ok",
+			vaultVersion: "1.16.0",
+			status:       http.StatusOK,
+			wantID:       "auth/foo",
+			wantVersion:  "v1.0.0",
+		},
+		{
+			name:         "not-found",
+			vaultVersion: "1.16.0",
+			status:       http.StatusNotFound,
+			wantID:       "",
+		},
+		{
+			name:         "server-error",
+			vaultVersion: "1.16.0",
+			status:       http.StatusInternalServerError,
+			wantErr:      true,
+			wantID:       "auth/foo",
+		},
+		{
+			name:         "version-unsupported",
+			vaultVersion: "1.15.0",
+			status:       http.StatusOK,
+			wantErr:      true,
+			wantID:       "auth/foo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := testMockVaultMeta(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/v1/sys/seal-status":
+					w.Header().Set("Content-Type", "application/json")
+					json.NewEncoder(w).Encode(map[string]interface{}{
+						consts.FieldVersion: tt.vaultVersion,
+					})
+				case "/v1/sys/plugins/pins/auth/foo":
+					if tt.status != http.StatusOK {
+						w.WriteHeader(tt.status)
+						return
+					}
+					w.Header().Set("Content-Type", "application/json")
+					json.NewEncoder(w).Encode(map[string]interface{}{
+						"data": map[string]interface{}{
+							consts.FieldVersion: "v1.0.0",
+						},
+					})
+				default:
+					w.WriteHeader(http.StatusNotImplemented)
+				}
+			})
+
+			r := pluginPinnedVersionResource()
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+			d.SetId("auth/foo")
+
+			diags := r.ReadContext(context.Background(), d, meta)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("read error = %v, wantErr %v", diags, tt.wantErr)
+			}
+
+			if d.Id() != tt.wantID {
+				t.Errorf("expected ID %q, got %q", tt.wantID, d.Id())
+			}
+			if got := d.Get(consts.FieldVersion).(string); got != tt.wantVersion {
+				t.Errorf("expected %s %q, got %q", consts.FieldVersion, tt.wantVersion, got)
+			}
+		})
+	}
+}
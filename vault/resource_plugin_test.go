@@ -5,12 +5,15 @@ package vault
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"strconv"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -150,3 +153,109 @@ func TestPluginFromID(t *testing.T) {
 		})
 	}
 }
+
+func TestPluginRead(t *testing.T) {
+	tests := []struct {
+		name         string
+		id           string
+		vaultVersion string
+		status       int
+		wantErr      bool
+		wantID       string
+		wantSHA256   string
+	}{
+		{
+			name:         "ok",
+			id:           "auth/version/v1.0.0/name/foo",
+			vaultVersion: "1.15.0",
+			status:       http.StatusOK,
+			wantID:       "auth/version/v1.0.0/name/foo",
+			wantSHA256:   "abc123",
+		},
+		{
+			name:         "not-found",
+			id:           "auth/version/v1.0.0/name/foo",
+			vaultVersion: "1.15.0",
+			status:       http.StatusNotFound,
+			wantID:       "",
+		},
+		{
+			name:         "server-error",
+			id:           "auth/version/v1.0.0/name/foo",
+			vaultVersion: "1.15.0",
+			status:       http.StatusInternalServerError,
+			wantErr:      true,
+			wantID:       "auth/version/v1.0.0/name/foo",
+		},
+		{
+			name:         "version-unsupported",
+			id:           "auth/version/v1.0.0/name/foo",
+			vaultVersion: "1.11.0",
+			status:       http.StatusOK,
+			wantErr:      true,
+			wantID:       "auth/version/v1.0.0/name/foo",
+		},
+		{
+			name:         "unversioned-before-1.12",
+			id:           "auth/name/foo",
+			vaultVersion: "1.11.0",
+			status:       http.StatusOK,
+			wantID:       "auth/name/foo",
+			wantSHA256:   "abc123",
+		},
+		{
+			name:         "invalid-id",
+			id:           "foo",
+			vaultVersion: "1.15.0",
+			status:       http.StatusOK,
+			wantErr:      true,
+			wantID:       "foo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := testMockVaultMeta(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/v1/sys/seal-status":
+					w.Header().Set("Content-Type", "application/json")
+					json.NewEncoder(w).Encode(map[string]interface{}{
+						consts.FieldVersion: tt.vaultVersion,
+					})
+				case "/v1/sys/plugins/catalog/auth/foo":
+					if tt.status != http.StatusOK {
+						w.WriteHeader(tt.status)
+						return
+					}
+					w.Header().Set("Content-Type", "application/json")
+					json.NewEncoder(w).Encode(map[string]interface{}{
+						"data": map[string]interface{}{
+							consts.FieldName:    "foo",
+							consts.FieldVersion: r.URL.Query().Get(consts.FieldVersion),
+							fieldSHA256:         "abc123",
+							fieldCommand:        "foo",
+						},
+					})
+				default:
+					w.WriteHeader(http.StatusNotImplemented)
+				}
+			})
+
+			r := pluginResource()
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+			d.SetId(tt.id)
+
+			diags := r.ReadContext(context.Background(), d, meta)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("read error = %v, wantErr %v", diags, tt.wantErr)
+			}
+
+			if d.Id() != tt.wantID {
+				t.Errorf("expected ID %q, got %q", tt.wantID, d.Id())
+			}
+			if got := d.Get(fieldSHA256).(string); got != tt.wantSHA256 {
+				t.Errorf("expected %s %q, got %q", fieldSHA256, tt.wantSHA256, got)
+			}
+		})
+	}
+}
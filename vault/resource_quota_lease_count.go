@@ -174,7 +174,6 @@ func quotaLeaseCountUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
-		d.SetId("")
 		return fmt.Errorf("Error updating Resource Lease Count Quota %s: %s", name, err)
 	}
 	log.Printf("[DEBUG] Updated Resource Lease Count Quota %s", name)
@@ -194,7 +193,7 @@ func quotaLeaseCountDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Deleting Resource Lease Count Quota %s", name)
 	_, err := client.Logical().Delete(path)
 	if err != nil {
-		return fmt.Errorf("Error deleting Resource Lease Count Quota %s", name)
+		return fmt.Errorf("Error deleting Resource Lease Count Quota %s: %s", name, err)
 	}
 	log.Printf("[DEBUG] Deleted Resource Lease Count Quota %s", name)
 
@@ -251,7 +251,7 @@ func quotaRateLimitUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	if v, ok := d.GetOk("group_by"); ok {
 		if !provider.IsAPISupported(meta, provider.VaultVersion120) {
-			return fmt.Errorf("group_by is only supported in Vault Enterpprise 1.20 and later")
+			return fmt.Errorf("group_by is only supported in Vault Enterprise 1.20 and later")
 		}
 		data["group_by"] = v
 	}
@@ -300,7 +300,7 @@ func quotaRateLimitDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Deleting Resource Rate Limit Quota %s", name)
 	_, err := client.Logical().Delete(path)
 	if err != nil {
-		return fmt.Errorf("Error deleting Resource Rate Limit Quota %s", name)
+		return fmt.Errorf("Error deleting Resource Rate Limit Quota %s: %s", name, err)
 	}
 	log.Printf("[DEBUG] Deleted Resource Rate Limit Quota %s", name)
 
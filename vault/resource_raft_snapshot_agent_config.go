@@ -80,11 +80,13 @@ func raftSnapshotAgentConfigResource() *schema.Resource {
 			Type:        schema.TypeString,
 			Description: "AWS secret access key.",
 			Optional:    true,
+			Sensitive:   true,
 		},
 		"aws_session_token": {
 			Type:        schema.TypeString,
 			Description: "AWS session token.",
 			Optional:    true,
+			Sensitive:   true,
 		},
 		"aws_s3_endpoint": {
 			Type:        schema.TypeString,
@@ -125,6 +127,7 @@ func raftSnapshotAgentConfigResource() *schema.Resource {
 			Type:        schema.TypeString,
 			Description: "Google service account key in JSON format.",
 			Optional:    true,
+			Sensitive:   true,
 		},
 		"google_endpoint": {
 			Type:        schema.TypeString,
@@ -150,6 +153,7 @@ func raftSnapshotAgentConfigResource() *schema.Resource {
 			Type:        schema.TypeString,
 			Description: "Azure account key.",
 			Optional:    true,
+			Sensitive:   true,
 		},
 		"azure_blob_environment": {
 			Type:        schema.TypeString,
@@ -362,7 +366,7 @@ func readSnapshotAgentConfigResource(d *schema.ResourceData, meta interface{}) e
 
 	if val, ok := resp.Data["local_max_space"]; ok {
 		if err := d.Set("local_max_space", val); err != nil {
-			return fmt.Errorf("error setting state key 'storage_type': %s", err)
+			return fmt.Errorf("error setting state key 'local_max_space': %s", err)
 		}
 	}
 
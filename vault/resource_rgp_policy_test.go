@@ -39,6 +39,20 @@ func TestAccRoleGoverningPolicy(t *testing.T) {
 					resource.TestCheckResourceAttrSet("vault_rgp_policy.test", "policy"),
 				),
 			},
+			{
+				// the policy is recreated after it was deleted outside of Terraform
+				PreConfig: func() {
+					client := testProvider.Meta().(*provider.ProviderMeta).MustGetClient()
+					if err := DeleteSentinelPolicy(client, "rgp", policyName); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccRoleGoverningPolicy(policyName, "hard-mandatory"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_rgp_policy.test", "name", policyName),
+					resource.TestCheckResourceAttr("vault_rgp_policy.test", "enforcement_level", "hard-mandatory"),
+				),
+			},
 		},
 	})
 }
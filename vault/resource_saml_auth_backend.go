@@ -139,6 +139,10 @@ func samlAuthBackendWrite(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	log.Printf("[DEBUG] Enabled SAML auth backend %q", path)
 
+	if err := waitForPath(ctx, meta, client, "sys/auth/"+path); err != nil {
+		return diag.FromErr(err)
+	}
+
 	// set ID to where engine is mounted
 	d.SetId(path)
 
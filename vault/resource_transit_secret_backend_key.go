@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/vault/api"
 )
 
 var (
@@ -110,9 +111,17 @@ func transitSecretBackendKeyResource() *schema.Resource {
 				Description: "Latest key version in use in the keyring",
 			},
 			"min_available_version": {
-				Type:        schema.TypeInt,
-				Computed:    true,
-				Description: "Minimum key version available for use.",
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				Description: "Minimum key version available for use. If set, the key versions below it " +
+					"are permanently removed from the keyring.",
+			},
+			"rotate_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Arbitrary value that rotates the key to a new version whenever it is changed, " +
+					"e.g. a timestamp or a counter.",
 			},
 			"min_decryption_version": {
 				Type:        schema.TypeInt,
@@ -218,7 +227,7 @@ func transitSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) err
 	autoRotatePeriod := getTransitAutoRotatePeriod(d)
 	configData := map[string]interface{}{
 		"min_decryption_version": d.Get("min_decryption_version").(int),
-		"min_encryption_versoin": d.Get("min_encryption_version").(int),
+		"min_encryption_version": d.Get("min_encryption_version").(int),
 		"deletion_allowed":       d.Get("deletion_allowed").(bool),
 		"exportable":             d.Get("exportable").(bool),
 		"allow_plaintext_backup": d.Get("allow_plaintext_backup").(bool),
@@ -261,6 +270,12 @@ func transitSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("error setting configuration for transit secret backend key %q: %s", path, conferr)
 	}
 
+	if v, ok := d.GetOk("min_available_version"); ok {
+		if err := transitSecretBackendKeyTrim(client, path, v.(int)); err != nil {
+			return err
+		}
+	}
+
 	log.Printf("[DEBUG] Created encryption key %s on transit secret backend %q", name, backend)
 	d.SetId(path)
 	return transitSecretBackendKeyRead(d, meta)
@@ -423,6 +438,16 @@ func transitSecretBackendKeyUpdate(d *schema.ResourceData, meta interface{}) err
 
 	path := d.Id()
 
+	// the key is rotated before the config is written, so that the
+	// min_encryption_version can be set to the new version in the same apply
+	if d.HasChange("rotate_trigger") {
+		log.Printf("[DEBUG] Rotating transit secret backend key %q", path)
+		if _, err := client.Logical().Write(path+"/rotate", nil); err != nil {
+			return fmt.Errorf("error rotating transit secret backend key %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Rotated transit secret backend key %q", path)
+	}
+
 	log.Printf("[DEBUG] Updating transit secret backend key %q", path)
 
 	data := map[string]interface{}{
@@ -440,6 +465,14 @@ func transitSecretBackendKeyUpdate(d *schema.ResourceData, meta interface{}) err
 	}
 	log.Printf("[DEBUG] Updated transit secret backend key %q", path)
 
+	if d.HasChange("min_available_version") {
+		if v, ok := d.GetOk("min_available_version"); ok {
+			if err := transitSecretBackendKeyTrim(client, path, v.(int)); err != nil {
+				return err
+			}
+		}
+	}
+
 	return transitSecretBackendKeyRead(d, meta)
 }
 
@@ -459,6 +492,21 @@ func transitSecretBackendKeyDelete(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+// transitSecretBackendKeyTrim permanently removes the key versions below the
+// minimum available version.
+func transitSecretBackendKeyTrim(client *api.Client, path string, minAvailableVersion int) error {
+	log.Printf("[DEBUG] Trimming transit secret backend key %q to version %d", path, minAvailableVersion)
+	_, err := client.Logical().Write(path+"/trim", map[string]interface{}{
+		"min_available_version": minAvailableVersion,
+	})
+	if err != nil {
+		return fmt.Errorf("error trimming transit secret backend key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Trimmed transit secret backend key %q to version %d", path, minAvailableVersion)
+
+	return nil
+}
+
 func transitSecretBackendKeyPath(backend string, name string) string {
 	return strings.Trim(backend, "/") + "/keys/" + strings.Trim(name, "/")
 }
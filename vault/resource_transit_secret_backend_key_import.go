@@ -0,0 +1,423 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+// transitImportHashFunctions maps the hash functions supported by Vault for
+// the RSA-OAEP wrapping of the ephemeral AES key.
+var transitImportHashFunctions = map[string]crypto.Hash{
+	"SHA1":   crypto.SHA1,
+	"SHA224": crypto.SHA224,
+	"SHA256": crypto.SHA256,
+	"SHA384": crypto.SHA384,
+	"SHA512": crypto.SHA512,
+}
+
+func transitSecretBackendKeyImportResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: transitSecretBackendKeyImportCreate,
+		ReadContext:   provider.ReadContextWrapper(transitSecretBackendKeyImportRead),
+		UpdateContext: transitSecretBackendKeyImportUpdate,
+		DeleteContext: transitSecretBackendKeyImportDelete,
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Transit secret backend the key is imported into.",
+			},
+			consts.FieldName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the imported key.",
+			},
+			consts.FieldKeyMaterialWO: {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Description: "Base64 encoded key material to import. Symmetric keys are the raw key bytes, " +
+					"asymmetric keys are the PKCS#8 DER encoded private key.",
+			},
+			consts.FieldKeyMaterialWOVersion: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "Version counter for the write-only key material. Incrementing it imports " +
+					"the key material as a new version of the key.",
+			},
+			consts.FieldType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "aes256-gcm96",
+				Description: "The type of the imported key.",
+			},
+			consts.FieldHashFunction: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "SHA256",
+				Description:  "The hash function used for the RSA-OAEP wrapping of the ephemeral AES key.",
+				ValidateFunc: validation.StringInSlice([]string{"SHA1", "SHA224", "SHA256", "SHA384", "SHA512"}, false),
+			},
+			consts.FieldAllowRotation: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Whether the imported key may be rotated within Vault.",
+			},
+			consts.FieldDerived: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Whether key derivation is used. If enabled, all requests must provide a context.",
+			},
+			consts.FieldContext: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Base64 encoded context for key derivation, required if derived is set.",
+			},
+			consts.FieldExportable: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Whether the key may be exported. Once set, this cannot be disabled.",
+			},
+			consts.FieldAllowPlaintextBackup: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Whether a plaintext backup of the key may be taken. Once set, this cannot be disabled.",
+			},
+			consts.FieldAutoRotatePeriod: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Amount of seconds the key should live before being automatically rotated, requires allow_rotation.",
+			},
+			consts.FieldDeletionAllowed: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the key may be deleted.",
+			},
+			consts.FieldLatestVersion: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Latest key version in the keyring.",
+			},
+		},
+	}
+}
+
+func transitSecretBackendKeyImportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := strings.Trim(d.Get(consts.FieldBackend).(string), "/")
+	name := d.Get(consts.FieldName).(string)
+	path := transitSecretBackendKeyPath(backend, name)
+
+	hashFunction := d.Get(consts.FieldHashFunction).(string)
+	ciphertext, err := transitSecretBackendKeyImportCiphertext(ctx, client, d, backend, hashFunction)
+	if err != nil {
+		return diag.Errorf("error wrapping key for import into %q: %s", path, err)
+	}
+
+	data := map[string]interface{}{
+		consts.FieldCiphertext:   ciphertext,
+		consts.FieldType:         d.Get(consts.FieldType),
+		consts.FieldHashFunction: hashFunction,
+	}
+
+	importFields := []string{
+		consts.FieldAllowRotation,
+		consts.FieldDerived,
+		consts.FieldContext,
+		consts.FieldExportable,
+		consts.FieldAllowPlaintextBackup,
+		consts.FieldAutoRotatePeriod,
+	}
+	for _, k := range importFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Importing key %q", path)
+	if _, err := client.Logical().WriteWithContext(ctx, path+"/import", data); err != nil {
+		return diag.Errorf("error importing key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Imported key %q", path)
+
+	d.SetId(path)
+
+	if d.Get(consts.FieldDeletionAllowed).(bool) {
+		if err := transitSecretBackendKeyImportWriteConfig(ctx, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return transitSecretBackendKeyImportRead(ctx, d, meta)
+}
+
+func transitSecretBackendKeyImportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	resp, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return diag.Errorf("error reading key %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] Key %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if v, ok := resp.Data[consts.FieldDeletionAllowed]; ok {
+		if err := d.Set(consts.FieldDeletionAllowed, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if v, ok := resp.Data[consts.FieldLatestVersion].(json.Number); ok {
+		latestVersion, err := v.Int64()
+		if err != nil {
+			return diag.Errorf("expected %s %q to be a number", consts.FieldLatestVersion, v)
+		}
+		if err := d.Set(consts.FieldLatestVersion, latestVersion); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func transitSecretBackendKeyImportUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(consts.FieldKeyMaterialWOVersion) {
+		client, e := provider.GetClient(d, meta)
+		if e != nil {
+			return diag.FromErr(e)
+		}
+
+		path := d.Id()
+		backend := strings.Trim(d.Get(consts.FieldBackend).(string), "/")
+		hashFunction := d.Get(consts.FieldHashFunction).(string)
+		ciphertext, err := transitSecretBackendKeyImportCiphertext(ctx, client, d, backend, hashFunction)
+		if err != nil {
+			return diag.Errorf("error wrapping key for import into %q: %s", path, err)
+		}
+
+		data := map[string]interface{}{
+			consts.FieldCiphertext:   ciphertext,
+			consts.FieldHashFunction: hashFunction,
+		}
+
+		log.Printf("[DEBUG] Importing new version of key %q", path)
+		if _, err := client.Logical().WriteWithContext(ctx, path+"/import_version", data); err != nil {
+			return diag.Errorf("error importing new version of key %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Imported new version of key %q", path)
+	}
+
+	if d.HasChange(consts.FieldDeletionAllowed) {
+		if err := transitSecretBackendKeyImportWriteConfig(ctx, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return transitSecretBackendKeyImportRead(ctx, d, meta)
+}
+
+// transitSecretBackendKeyImportCiphertext wraps the write-only key material
+// with the wrapping key of the backend for the import endpoints.
+func transitSecretBackendKeyImportCiphertext(ctx context.Context, client *api.Client, d *schema.ResourceData, backend, hashFunction string) (string, error) {
+	v, _ := d.GetRawConfigAt(cty.GetAttrPath(consts.FieldKeyMaterialWO))
+	if !v.IsKnown() || v.IsNull() {
+		return "", fmt.Errorf("%q must be set", consts.FieldKeyMaterialWO)
+	}
+
+	keyMaterial, err := base64.StdEncoding.DecodeString(v.AsString())
+	if err != nil {
+		return "", fmt.Errorf("error decoding %q: %s", consts.FieldKeyMaterialWO, err)
+	}
+
+	wrappingKeyPath := backend + "/wrapping_key"
+	resp, err := client.Logical().ReadWithContext(ctx, wrappingKeyPath)
+	if err != nil {
+		return "", fmt.Errorf("error reading wrapping key from %q: %s", wrappingKeyPath, err)
+	}
+	if resp == nil {
+		return "", fmt.Errorf("no wrapping key found at %q", wrappingKeyPath)
+	}
+
+	wrappingKey, err := parseTransitWrappingKey(resp.Data[consts.FieldPublicKey])
+	if err != nil {
+		return "", fmt.Errorf("error parsing wrapping key from %q: %s", wrappingKeyPath, err)
+	}
+
+	return wrapTransitImportKey(wrappingKey, transitImportHashFunctions[hashFunction], keyMaterial)
+}
+
+func transitSecretBackendKeyImportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting key %q", path)
+	if _, err := client.Logical().DeleteWithContext(ctx, path); err != nil {
+		return diag.Errorf("error deleting key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted key %q", path)
+
+	return nil
+}
+
+func transitSecretBackendKeyImportWriteConfig(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	path := d.Id()
+	data := map[string]interface{}{
+		consts.FieldDeletionAllowed: d.Get(consts.FieldDeletionAllowed),
+	}
+
+	log.Printf("[DEBUG] Updating config of key %q", path)
+	if _, err := client.Logical().WriteWithContext(ctx, path+"/config", data); err != nil {
+		return fmt.Errorf("error updating config of key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated config of key %q", path)
+
+	return nil
+}
+
+func parseTransitWrappingKey(v interface{}) (*rsa.PublicKey, error) {
+	s, _ := v.(string)
+	b, _ := pem.Decode([]byte(s))
+	if b == nil {
+		return nil, errors.New("no PEM encoded public key found")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(b.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("expected an RSA public key, got %T", key)
+	}
+
+	return rsaKey, nil
+}
+
+// wrapTransitImportKey wraps the key material the way Vault expects it for
+// an import: an ephemeral AES-256 key is wrapped with the RSA wrapping key
+// using RSA-OAEP, and the key material is wrapped with the ephemeral key
+// using AES-KWP. The result is the base64 encoded concatenation of both.
+func wrapTransitImportKey(wrappingKey *rsa.PublicKey, hash crypto.Hash, keyMaterial []byte) (string, error) {
+	ephemeralKey := make([]byte, 32)
+	if _, err := rand.Read(ephemeralKey); err != nil {
+		return "", err
+	}
+
+	wrappedEphemeralKey, err := rsa.EncryptOAEP(hash.New(), rand.Reader, wrappingKey, ephemeralKey, nil)
+	if err != nil {
+		return "", err
+	}
+
+	wrappedKeyMaterial, err := aesKeyWrapWithPadding(ephemeralKey, keyMaterial)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(append(wrappedEphemeralKey, wrappedKeyMaterial...)), nil
+}
+
+// aesKeyWrapWithPadding implements the AES key wrap with padding algorithm
+// of RFC 5649.
+func aesKeyWrapWithPadding(kek, plaintext []byte) ([]byte, error) {
+	if len(plaintext) == 0 {
+		return nil, errors.New("plaintext must not be empty")
+	}
+
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+
+	// the alternative initial value holds the length of the plaintext
+	aiv := make([]byte, 8)
+	copy(aiv, []byte{0xa6, 0x59, 0x59, 0xa6})
+	binary.BigEndian.PutUint32(aiv[4:], uint32(len(plaintext)))
+
+	padded := make([]byte, (len(plaintext)+7)/8*8)
+	copy(padded, plaintext)
+
+	// a single block is encrypted directly
+	if len(padded) == 8 {
+		out := make([]byte, 16)
+		block.Encrypt(out, append(aiv, padded...))
+		return out, nil
+	}
+
+	n := len(padded) / 8
+	a := aiv
+	r := padded
+	b := make([]byte, 16)
+	for j := 0; j < 6; j++ {
+		for i := 0; i < n; i++ {
+			copy(b, a)
+			copy(b[8:], r[i*8:(i+1)*8])
+			block.Encrypt(b, b)
+
+			t := uint64(n*j + i + 1)
+			a = make([]byte, 8)
+			binary.BigEndian.PutUint64(a, binary.BigEndian.Uint64(b[:8])^t)
+			copy(r[i*8:(i+1)*8], b[8:])
+		}
+	}
+
+	return append(a, r...), nil
+}
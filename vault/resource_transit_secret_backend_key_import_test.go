@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestTransitSecretBackendKeyImport_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	name := acctest.RandomWithPrefix("key")
+	resourceName := "vault_transit_secret_backend_key_import.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy:             testTransitSecretBackendKeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testTransitSecretBackendKeyImportConfig(backend, name, "0123456789abcdef0123456789abcdef", 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldBackend, backend),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldName, name),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldType, "aes256-gcm96"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDeletionAllowed, "true"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldLatestVersion, "1"),
+					// the imported key decrypts what it encrypted
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "plaintext", "foo"),
+				),
+			},
+			{
+				// incrementing the version imports the key material as a new key version
+				Config: testTransitSecretBackendKeyImportConfig(backend, name, "fedcba9876543210fedcba9876543210", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldKeyMaterialWOVersion, "1"),
+					resource.TestCheckNoResourceAttr(resourceName, consts.FieldKeyMaterialWO),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldLatestVersion, "2"),
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "plaintext", "foo"),
+				),
+			},
+		},
+	})
+}
+
+func testTransitSecretBackendKeyImportConfig(backend, name, keyMaterial string, version int) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key_import" "test" {
+  backend          = vault_mount.transit.path
+  name             = "%s"
+  key_material_wo         = base64encode("%s")
+  key_material_wo_version = %d
+  deletion_allowed        = true
+}
+
+data "vault_transit_encrypt" "test" {
+  backend   = vault_transit_secret_backend_key_import.test.backend
+  key       = vault_transit_secret_backend_key_import.test.name
+  plaintext = "foo"
+}
+
+data "vault_transit_decrypt" "test" {
+  backend    = vault_transit_secret_backend_key_import.test.backend
+  key        = vault_transit_secret_backend_key_import.test.name
+  ciphertext = data.vault_transit_encrypt.test.ciphertext
+}
+`, backend, name, keyMaterial, version)
+}
+
+func TestAESKeyWrapWithPadding(t *testing.T) {
+	// test vectors from RFC 5649, section 6
+	kek := "5840df6e29b02af1ab493b705bf16ea1ae8338f4dcc176a8"
+
+	tests := []struct {
+		name      string
+		plaintext string
+		want      string
+	}{
+		{
+			name:      "20-octets",
+			plaintext: "c37b7e6492584340bed12207808941155068f738",
+			want:      "138bdeaa9b8fa7fc61f97742e72248ee5ae6ae5360d1ae6a5f54f373fa543b6a",
+		},
+		{
+			name:      "7-octets",
+			plaintext: "466f7250617369",
+			want:      "afbeb0f07dfbf5419200f2ccb50bb24f",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, _ := hex.DecodeString(kek)
+			p, _ := hex.DecodeString(tt.plaintext)
+
+			got, err := aesKeyWrapWithPadding(k, p)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if hex.EncodeToString(got) != tt.want {
+				t.Errorf("aesKeyWrapWithPadding() = %x, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -86,6 +86,52 @@ func TestTransitSecretBackendKey_basic(t *testing.T) {
 	})
 }
 
+func TestTransitSecretBackendKey_rotate(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	name := acctest.RandomWithPrefix("key")
+	resourceName := "vault_transit_secret_backend_key.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy:             testTransitSecretBackendKeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testTransitSecretBackendKeyConfig_rotate(name, backend, "1", 1, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotate_trigger", "1"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "min_available_version", "0"),
+				),
+			},
+			{
+				// the key is rotated before the min versions are set to the new version
+				Config: testTransitSecretBackendKeyConfig_rotate(name, backend, "2", 2, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotate_trigger", "2"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "min_decryption_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "min_encryption_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "keys.#", "2"),
+				),
+			},
+			{
+				Config: testTransitSecretBackendKeyConfig_rotate(name, backend, "2", 2, "min_available_version = 2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "min_available_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "keys.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key_size", "rotate_trigger"},
+			},
+		},
+	})
+}
+
 func TestTransitSecretBackendKey_rsa4096(t *testing.T) {
 	backend := acctest.RandomWithPrefix("transit")
 	name := acctest.RandomWithPrefix("key")
@@ -226,6 +272,25 @@ resource "vault_transit_secret_backend_key" "test" {
 `, path, name)
 }
 
+func testTransitSecretBackendKeyConfig_rotate(name, path, trigger string, minVersion int, extraFields string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend                = vault_mount.transit.path
+  name                   = "%s"
+  deletion_allowed       = true
+  rotate_trigger         = "%s"
+  min_decryption_version = %d
+  min_encryption_version = %d
+  %s
+}
+`, path, name, trigger, minVersion, minVersion, extraFields)
+}
+
 func testTransitSecretBackendKeyConfig_rsa4096(name, path string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "transit" {
@@ -108,6 +108,11 @@ func sentinelPolicyRead(policyType string, attributes []string, d *schema.Resour
 	if err != nil {
 		return fmt.Errorf("error reading from Vault: %s", err)
 	}
+	if policy == nil {
+		log.Printf("[WARN] %s policy %s not found, removing from state", policyType, name)
+		d.SetId("")
+		return nil
+	}
 
 	for _, value := range attributes {
 		d.Set(value, policy[value])
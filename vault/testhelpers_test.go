@@ -136,3 +136,38 @@ func SkipOnAPIVersion(t *testing.T, m interface{}, cmp func(*version.Version) bo
 		t.Skipf(format, args...)
 	}
 }
+
+// testCheckPKIConfigDisabled checks that the PKI config at path still exists
+// and that each of the boolean fields is false, for configs that are disabled
+// rather than deleted on destroy.
+func testCheckPKIConfigDisabled(path string, fields ...string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client := testProvider.Meta().(*provider.ProviderMeta).MustGetClient()
+
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			return fmt.Errorf("expected config at %q", path)
+		}
+
+		for _, f := range fields {
+			if v, _ := resp.Data[f].(bool); v {
+				return fmt.Errorf("expected %q to be false at %q", f, path)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccPKISecretBackendConfigMount(path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+	path        = "%s"
+	type        = "pki"
+    description = "PKI secret engine mount"
+}
+`, path)
+}